@@ -0,0 +1,9 @@
+// Retrieve the order of the ranges of a specific network using filters
+data "nios_dhcp_orderedranges" "get_ordered_ranges_using_filters" {
+  filters = {
+    network = nios_ipam_network.pxe_network.ref
+  }
+}
+
+// Retrieve the order of the ranges of all networks
+data "nios_dhcp_orderedranges" "get_all_ordered_ranges" {}
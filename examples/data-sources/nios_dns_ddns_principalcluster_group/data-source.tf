@@ -0,0 +1,9 @@
+// Retrieve a specific DDNS Principal Cluster Group by filters
+data "nios_dns_ddns_principalcluster_group" "get_ddns_principal_cluster_group_using_filters" {
+  filters = {
+    name = "example_ddns_principal_cluster_group"
+  }
+}
+
+// Retrieve all DDNS Principal Cluster Groups
+data "nios_dns_ddns_principalcluster_group" "get_all_ddns_principal_cluster_groups" {}
@@ -0,0 +1,16 @@
+// Retrieve a specific DNS64 Group by filters
+data "nios_dns_dns64group" "get_dns64_group_using_filters" {
+  filters = {
+    name = "example_dns64_group"
+  }
+}
+
+// Retrieve specific DNS64 Groups using Extensible Attributes
+data "nios_dns_dns64group" "get_dns64_groups_using_extensible_attributes" {
+  extattrfilters = {
+    Site = "location-1"
+  }
+}
+
+// Retrieve all DNS64 Groups
+data "nios_dns_dns64group" "get_all_dns64_groups" {}
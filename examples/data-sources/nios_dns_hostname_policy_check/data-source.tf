@@ -0,0 +1,15 @@
+// Test hostnames against a Record Name Policy
+data "nios_dns_hostname_policy_check" "check_record_name_policy" {
+  record_name_policy = "Strict Hostname Checking"
+  hostnames          = ["web-01.example.com", "web_02.example.com"]
+}
+
+// Test hostnames against a Hostname Rewrite Policy and get the rewritten hostnames
+data "nios_dns_hostname_policy_check" "check_hostname_rewrite_policy" {
+  hostname_rewrite_policy = "Default"
+  hostnames               = ["laptop 01", "printer#2"]
+}
+
+output "non_compliant_hostnames" {
+  value = [for r in data.nios_dns_hostname_policy_check.check_record_name_policy.results : r.hostname if !r.compliant]
+}
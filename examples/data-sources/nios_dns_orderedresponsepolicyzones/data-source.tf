@@ -0,0 +1,9 @@
+// Retrieve the order of the Response Policy Zones of a DNS view
+data "nios_dns_orderedresponsepolicyzones" "get_ordered_rpz_using_filters" {
+  filters = {
+    view = "default"
+  }
+}
+
+// Retrieve the order of the Response Policy Zones of all DNS views
+data "nios_dns_orderedresponsepolicyzones" "get_all_ordered_rpz" {}
@@ -0,0 +1,9 @@
+// Retrieve specific DHCID records using filters
+data "nios_dns_record_dhcid" "get_records_using_filters" {
+  filters = {
+    name = "dhcid.example.com"
+  }
+}
+
+// Retrieve all DHCID records
+data "nios_dns_record_dhcid" "get_all_dhcid_records" {}
@@ -0,0 +1,9 @@
+// Retrieve specific DNSKEY records using filters
+data "nios_dns_record_dnskey" "get_records_using_filters" {
+  filters = {
+    zone = "dnssec.example.com"
+  }
+}
+
+// Retrieve all DNSKEY records
+data "nios_dns_record_dnskey" "get_all_dnskey_records" {}
@@ -0,0 +1,9 @@
+// Retrieve specific DS records using filters
+data "nios_dns_record_ds" "get_records_using_filters" {
+  filters = {
+    name = "dnssec.example.com"
+  }
+}
+
+// Retrieve all DS records
+data "nios_dns_record_ds" "get_all_ds_records" {}
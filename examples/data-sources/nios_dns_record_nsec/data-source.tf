@@ -0,0 +1,9 @@
+// Retrieve specific NSEC records using filters
+data "nios_dns_record_nsec" "get_records_using_filters" {
+  filters = {
+    zone = "dnssec.example.com"
+  }
+}
+
+// Retrieve all NSEC records
+data "nios_dns_record_nsec" "get_all_nsec_records" {}
@@ -0,0 +1,9 @@
+// Retrieve specific NSEC3 records using filters
+data "nios_dns_record_nsec3" "get_records_using_filters" {
+  filters = {
+    zone = "nsec3.example.com"
+  }
+}
+
+// Retrieve all NSEC3 records
+data "nios_dns_record_nsec3" "get_all_nsec3_records" {}
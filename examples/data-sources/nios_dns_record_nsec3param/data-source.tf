@@ -0,0 +1,9 @@
+// Retrieve specific NSEC3PARAM records using filters
+data "nios_dns_record_nsec3param" "get_records_using_filters" {
+  filters = {
+    zone = "nsec3.example.com"
+  }
+}
+
+// Retrieve all NSEC3PARAM records
+data "nios_dns_record_nsec3param" "get_all_nsec3param_records" {}
@@ -0,0 +1,10 @@
+// Retrieve specific RRSIG records using filters
+data "nios_dns_record_rrsig" "get_records_using_filters" {
+  filters = {
+    zone         = "dnssec.example.com"
+    type_covered = "SOA"
+  }
+}
+
+// Retrieve all RRSIG records
+data "nios_dns_record_rrsig" "get_all_rrsig_records" {}
@@ -0,0 +1,9 @@
+// Retrieve a specific Record Name Policy by filters
+data "nios_dns_recordnamepolicy" "get_record_name_policy_using_filters" {
+  filters = {
+    name = "example_record_name_policy"
+  }
+}
+
+// Retrieve all Record Name Policies
+data "nios_dns_recordnamepolicy" "get_all_record_name_policies" {}
@@ -0,0 +1,9 @@
+// Retrieve the discrepancies of a specific Auth Zone using filters
+data "nios_dns_zone_auth_discrepancy" "get_zone_auth_discrepancies_using_filters" {
+  filters = {
+    zone = nios_dns_zone_auth.zone_warn_on_discrepancies.ref
+  }
+}
+
+// Retrieve all Auth Zone discrepancies
+data "nios_dns_zone_auth_discrepancy" "get_all_zone_auth_discrepancies" {}
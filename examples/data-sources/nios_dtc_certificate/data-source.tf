@@ -0,0 +1,2 @@
+// Retrieve all DTC certificates
+data "nios_dtc_certificate" "get_all_dtc_certificates" {}
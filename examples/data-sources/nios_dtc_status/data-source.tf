@@ -0,0 +1,22 @@
+// Retrieve the status of a DTC LBDN and its pools
+data "nios_dtc_status" "lbdn_status" {
+  lbdn = "dtc_lbdn"
+}
+
+// Retrieve the status of a DTC Pool and its servers
+data "nios_dtc_status" "pool_status" {
+  pool = "dtc_pool"
+}
+
+// Retrieve the status of a DTC Server
+data "nios_dtc_status" "server_status" {
+  server = "dtc_server"
+}
+
+// Fail the plan unless every server of the pool is available
+check "pool_is_green" {
+  assert {
+    condition     = alltrue([for member in data.nios_dtc_status.pool_status.members : member.healthy])
+    error_message = "Not all servers of dtc_pool are available: ${join(", ", [for member in data.nios_dtc_status.pool_status.members : "${member.name} (${member.availability}: ${member.description})" if !member.healthy])}"
+  }
+}
@@ -0,0 +1,9 @@
+// Retrieve specific DTC Topology labels using filters
+data "nios_dtc_topology_label" "get_topology_labels_using_filters" {
+  filters = {
+    field = "Continent"
+  }
+}
+
+// Retrieve all DTC Topology labels
+data "nios_dtc_topology_label" "get_all_topology_labels" {}
@@ -0,0 +1,20 @@
+// Retrieve a specific Federated Realm by filters
+data "nios_federatedrealms" "get_federated_realm_using_filters" {
+  filters = {
+    name = "example-federated-realm"
+  }
+}
+
+// Retrieve all Federated Realms
+data "nios_federatedrealms" "get_all_federated_realms" {}
+
+// Associate a Network with a Federated Realm
+resource "nios_ipam_network" "example_federated_network" {
+  network = "10.20.0.0/24"
+  federated_realms = [
+    {
+      name = data.nios_federatedrealms.get_federated_realm_using_filters.result[0].name
+      id   = data.nios_federatedrealms.get_federated_realm_using_filters.result[0].id
+    }
+  ]
+}
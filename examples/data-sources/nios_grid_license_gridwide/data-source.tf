@@ -0,0 +1,2 @@
+// Retrieve all Grid-wide Licenses
+data "nios_grid_license_gridwide" "all_gridwide_licenses" {}
@@ -0,0 +1,9 @@
+// Retrieve all Grid License Pools
+data "nios_grid_license_pool" "all_license_pools" {}
+
+// Retrieve the DNS License Pools
+data "nios_grid_license_pool" "dns_license_pools" {
+  filters = {
+    type = "DNS"
+  }
+}
@@ -0,0 +1,2 @@
+// Retrieve the Grid License Pool Container
+data "nios_grid_license_pool_container" "license_pool_container" {}
@@ -0,0 +1,9 @@
+// Retrieve the information about the deployed GeoIP database
+data "nios_grid_maxminddbinfo" "get_geoip_database_info" {
+  filters = {
+    topology_type = "GEOIP"
+  }
+}
+
+// Retrieve the information about all deployed Topology databases
+data "nios_grid_maxminddbinfo" "get_all_database_info" {}
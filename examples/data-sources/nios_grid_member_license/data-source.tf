@@ -0,0 +1,20 @@
+// Retrieve all Member Licenses
+data "nios_grid_member_license" "all_member_licenses" {}
+
+// Retrieve Member Licenses of a specific type
+data "nios_grid_member_license" "dns_licenses" {
+  filters = {
+    type = "DNS"
+  }
+}
+
+// List the licenses that expire soon
+output "expiring_member_licenses" {
+  value = [
+    for l in data.nios_grid_member_license.all_member_licenses.result : {
+      hwid        = l.hwid
+      type        = l.type
+      expiry_date = l.expiry_date
+    } if contains(["EXPIRING_SOON", "EXPIRING_VERY_SOON"], l.expiration_status)
+  ]
+}
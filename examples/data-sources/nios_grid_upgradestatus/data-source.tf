@@ -0,0 +1,14 @@
+// Retrieve the upgrade status of the Grid
+data "nios_grid_upgradestatus" "grid_status" {
+  filters = {
+    type = "GRID"
+  }
+}
+
+// Retrieve the upgrade status of the members of an upgrade group
+data "nios_grid_upgradestatus" "group_member_status" {
+  filters = {
+    type          = "MEMBER"
+    upgrade_group = "Grid Master"
+  }
+}
@@ -0,0 +1,9 @@
+// Retrieve a specific X509 Certificate by filters
+data "nios_grid_x509certificate" "get_x509certificate_using_filters" {
+  filters = {
+    issuer = "CN=\"Example Root CA\",O=\"Example\",C=\"US\""
+  }
+}
+
+// Retrieve all X509 Certificates
+data "nios_grid_x509certificate" "get_all_x509certificates" {}
@@ -0,0 +1,16 @@
+// Retrieve a specific IPAM Bulk Host by filters
+data "nios_ipam_bulkhost" "get_ipam_bulkhost_using_filters" {
+  filters = {
+    prefix = "lab"
+  }
+}
+
+// Retrieve specific IPAM Bulk Hosts using Extensible Attributes
+data "nios_ipam_bulkhost" "get_ipam_bulkhost_using_extensible_attributes" {
+  extattrfilters = {
+    Site = "location-1"
+  }
+}
+
+// Retrieve all IPAM Bulk Hosts
+data "nios_ipam_bulkhost" "get_all_ipam_bulkhosts" {}
@@ -0,0 +1,9 @@
+// Retrieve a specific Hostname Rewrite Policy by filters
+data "nios_ipam_hostnamerewritepolicy" "get_hostname_rewrite_policy_using_filters" {
+  filters = {
+    name = "Default"
+  }
+}
+
+// Retrieve all Hostname Rewrite Policies
+data "nios_ipam_hostnamerewritepolicy" "get_all_hostname_rewrite_policies" {}
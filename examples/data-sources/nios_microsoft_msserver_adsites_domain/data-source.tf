@@ -0,0 +1,15 @@
+// Retrieve a specific Active Directory Sites Domain using filters
+data "nios_microsoft_msserver_adsites_domain" "get_msserver_adsites_domain_using_filters" {
+  filters = {
+    name = "example.local"
+  }
+}
+
+// Retrieve all Active Directory Sites Domains
+data "nios_microsoft_msserver_adsites_domain" "get_all_msserver_adsites_domains" {}
+
+// Use the retrieved domain for an Active Directory Site
+resource "nios_microsoft_msserver_adsites_site" "msserver_adsites_from_domain" {
+  domain = data.nios_microsoft_msserver_adsites_domain.get_msserver_adsites_domain_using_filters.result[0].ref
+  name   = "example_adsite_3"
+}
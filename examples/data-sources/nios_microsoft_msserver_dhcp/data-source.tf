@@ -0,0 +1,9 @@
+// Retrieve the DHCP service of a specific Microsoft Server using filters
+data "nios_microsoft_msserver_dhcp" "get_msserver_dhcp_using_filters" {
+  filters = {
+    address = "10.10.0.10"
+  }
+}
+
+// Retrieve the DHCP services of all Microsoft Servers
+data "nios_microsoft_msserver_dhcp" "get_all_msserver_dhcp" {}
@@ -0,0 +1,9 @@
+// Retrieve the DNS service of a specific Microsoft Server using filters
+data "nios_microsoft_msserver_dns" "get_msserver_dns_using_filters" {
+  filters = {
+    address = "10.10.0.20"
+  }
+}
+
+// Retrieve the DNS services of all Microsoft Servers
+data "nios_microsoft_msserver_dns" "get_all_msserver_dns" {}
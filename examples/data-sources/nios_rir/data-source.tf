@@ -0,0 +1,9 @@
+// Retrieve a specific RIR by filters
+data "nios_rir" "get_rir_using_filters" {
+  filters = {
+    name = "RIPE"
+  }
+}
+
+// Retrieve all RIRs
+data "nios_rir" "get_all_rirs" {}
@@ -0,0 +1,9 @@
+// Retrieve a specific AD Auth Service by filters
+data "nios_security_ad_auth_service" "get_ad_authservice_using_filters" {
+  filters = {
+    name = "example_ad_authservice"
+  }
+}
+
+// Retrieve all AD Auth Services
+data "nios_security_ad_auth_service" "get_all_ad_authservices" {}
@@ -0,0 +1,16 @@
+// Retrieve a specific Approval Workflow by filters
+data "nios_security_approval_workflow" "get_approval_workflow_using_filters" {
+  filters = {
+    submitter_group = "example_submitter_group"
+  }
+}
+
+// Retrieve specific Approval Workflows using Extensible Attributes
+data "nios_security_approval_workflow" "get_approval_workflows_using_extensible_attributes" {
+  extattrfilters = {
+    Site = "location-1"
+  }
+}
+
+// Retrieve all Approval Workflows
+data "nios_security_approval_workflow" "get_all_approval_workflows" {}
@@ -0,0 +1,9 @@
+// Retrieve a specific CA Certificate by filters
+data "nios_security_cacertificate" "get_cacertificate_using_filters" {
+  filters = {
+    distinguished_name = "CN=\"Example Root CA\",O=\"Example\",C=\"US\""
+  }
+}
+
+// Retrieve all CA Certificates
+data "nios_security_cacertificate" "get_all_cacertificates" {}
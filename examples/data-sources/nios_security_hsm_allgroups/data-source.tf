@@ -0,0 +1,7 @@
+// Retrieve the status of all HSM groups
+data "nios_security_hsm_allgroups" "get_all_hsm_groups" {}
+
+// Expose the status of every HSM group by name
+output "hsm_group_status" {
+  value = { for group in data.nios_security_hsm_allgroups.get_all_hsm_groups.groups : group.name => group.status }
+}
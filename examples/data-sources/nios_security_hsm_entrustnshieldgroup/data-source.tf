@@ -0,0 +1,9 @@
+// Retrieve a specific Entrust nShield HSM group by filters
+data "nios_security_hsm_entrustnshieldgroup" "get_entrustnshieldgroup_using_filters" {
+  filters = {
+    name = "example_entrustnshieldgroup"
+  }
+}
+
+// Retrieve all Entrust nShield HSM groups
+data "nios_security_hsm_entrustnshieldgroup" "get_all_entrustnshieldgroups" {}
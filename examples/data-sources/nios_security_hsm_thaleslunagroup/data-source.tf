@@ -0,0 +1,9 @@
+// Retrieve a specific HSM Thales Luna group by filters
+data "nios_security_hsm_thaleslunagroup" "get_thaleslunagroup_using_filters" {
+  filters = {
+    name = "example_thaleslunagroup"
+  }
+}
+
+// Retrieve all HSM Thales Luna groups
+data "nios_security_hsm_thaleslunagroup" "get_all_thaleslunagroups" {}
@@ -0,0 +1,9 @@
+// Retrieve the HTTPS certificate served by a member
+data "nios_security_https_certificate" "get_https_certificate" {
+  member = "infoblox.localdomain"
+}
+
+// Expose the expiry date of the HTTPS certificate as a Unix timestamp
+output "https_certificate_valid_not_after" {
+  value = data.nios_security_https_certificate.get_https_certificate.valid_not_after
+}
@@ -0,0 +1,9 @@
+// Retrieve a specific Network User by filters
+data "nios_security_networkuser" "get_networkuser_using_filters" {
+  filters = {
+    name = "jdoe"
+  }
+}
+
+// Retrieve all Network Users
+data "nios_security_networkuser" "get_all_networkusers" {}
@@ -0,0 +1,2 @@
+// Retrieve the User Profile of the authenticated admin
+data "nios_security_userprofile" "get_userprofile" {}
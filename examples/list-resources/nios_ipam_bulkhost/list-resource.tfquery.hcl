@@ -0,0 +1,25 @@
+// List specific Bulk Hosts using filters
+list "nios_ipam_bulkhost" "list_bulkhosts_using_filters" {
+  provider = nios
+  config {
+    filters = {
+      prefix = "lab"
+    }
+  }
+}
+
+// List specific Bulk Hosts using Extensible Attributes
+list "nios_ipam_bulkhost" "list_bulkhosts_using_extensible_attributes" {
+  provider = nios
+  config {
+    extattrfilters = {
+      Site = "location-1"
+    }
+  }
+}
+
+// List Bulk Hosts with resource details included
+list "nios_ipam_bulkhost" "list_bulkhosts_with_resource" {
+  provider         = nios
+  include_resource = true
+}
@@ -0,0 +1,26 @@
+// Create a network with DHCP ranges to order
+resource "nios_ipam_network" "pxe_network" {
+  network      = "10.20.0.0/24"
+  network_view = "default"
+}
+
+resource "nios_dhcp_range" "general" {
+  start_addr = "10.20.0.100"
+  end_addr   = "10.20.0.200"
+  depends_on = [nios_ipam_network.pxe_network]
+}
+
+resource "nios_dhcp_range" "pxe_clients" {
+  start_addr = "10.20.0.10"
+  end_addr   = "10.20.0.50"
+  depends_on = [nios_ipam_network.pxe_network]
+}
+
+// Evaluate the PXE client range before the general range
+resource "nios_dhcp_orderedranges" "ordered_ranges" {
+  network = nios_ipam_network.pxe_network.ref
+  ranges = [
+    nios_dhcp_range.pxe_clients.ref,
+    nios_dhcp_range.general.ref,
+  ]
+}
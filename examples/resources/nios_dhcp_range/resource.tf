@@ -47,3 +47,17 @@ resource "nios_dhcp_range" "create_range_with_additional_fields" {
 
   depends_on = [nios_ipam_network.range_parent_network]
 }
+
+// Create a Range served by a Microsoft Server
+resource "nios_dhcp_range" "range_ms_server" {
+  start_addr   = "10.0.0.230"
+  end_addr     = "10.0.0.240"
+  network_view = "default"
+
+  server_association_type = "MS_SERVER"
+  ms_server = {
+    ipv4addr = "10.10.0.10"
+  }
+
+  depends_on = [nios_ipam_network.range_parent_network]
+}
@@ -0,0 +1,39 @@
+// Create the views of a split-horizon DNS
+resource "nios_dns_view" "internal" {
+  name = "internal"
+}
+
+// Create the zone in both views (Required as Parent)
+resource "nios_dns_zone_auth" "external_zone" {
+  fqdn = "example.com"
+  view = "default"
+}
+
+resource "nios_dns_zone_auth" "internal_zone" {
+  fqdn = "example.com"
+  view = nios_dns_view.internal.name
+}
+
+// Copy the records of the external zone to the internal zone, records already in the internal zone are kept.
+// Records added to the external zone later are not detected, they are only copied when the resource changes.
+resource "nios_dns_copyzonerecords" "copy_all_records" {
+  source_zone      = nios_dns_zone_auth.external_zone.ref
+  destination_zone = nios_dns_zone_auth.internal_zone.ref
+}
+
+// Copy only the A and CNAME records, replacing the records already in the destination zone.
+// Changing the triggers copies the records again.
+resource "nios_dns_zone_auth" "staging_zone" {
+  fqdn = "staging.example.com"
+  view = "default"
+}
+
+resource "nios_dns_copyzonerecords" "copy_selected_records" {
+  source_zone              = nios_dns_zone_auth.external_zone.ref
+  destination_zone         = nios_dns_zone_auth.staging_zone.ref
+  select_records           = ["A", "CNAME"]
+  replace_existing_records = true
+  triggers = {
+    release = "2026-10"
+  }
+}
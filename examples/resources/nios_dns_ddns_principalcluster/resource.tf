@@ -0,0 +1,20 @@
+// Create a DDNS Principal Cluster with Basic Fields, the cluster is added to the default group
+resource "nios_dns_ddns_principalcluster" "create_ddns_principal_cluster" {
+  name       = "example_ddns_principal_cluster"
+  principals = ["DHCP/dhcp1.example.com@EXAMPLE.COM"]
+}
+
+// Create a DDNS Principal Cluster with Additional Fields
+resource "nios_dns_ddns_principalcluster_group" "ddns_principal_cluster_group" {
+  name = "example_ddns_principal_cluster_group"
+}
+
+resource "nios_dns_ddns_principalcluster" "create_ddns_principal_cluster_with_additional_fields" {
+  name    = "example_ddns_principal_cluster_1"
+  comment = "Example DDNS Principal Cluster"
+  group   = nios_dns_ddns_principalcluster_group.ddns_principal_cluster_group.name
+  principals = [
+    "DHCP/dhcp1.example.com@EXAMPLE.COM",
+    "DHCP/dhcp2.example.com@EXAMPLE.COM",
+  ]
+}
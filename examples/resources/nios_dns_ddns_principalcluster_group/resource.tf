@@ -0,0 +1,18 @@
+// Create a DDNS Principal Cluster Group with Basic Fields
+resource "nios_dns_ddns_principalcluster_group" "create_ddns_principal_cluster_group" {
+  name = "example_ddns_principal_cluster_group"
+}
+
+// Create a DDNS Principal Cluster Group with Additional Fields
+resource "nios_dns_ddns_principalcluster_group" "create_ddns_principal_cluster_group_with_additional_fields" {
+  name    = "example_ddns_principal_cluster_group_1"
+  comment = "Example DDNS Principal Cluster Group"
+}
+
+// Use the DDNS Principal Cluster Group on an authoritative zone, the zone references the group by name
+resource "nios_dns_zone_auth" "create_zone_with_ddns_principal_group" {
+  fqdn                        = "example-ddns.com"
+  view                        = "default"
+  use_ddns_principal_security = true
+  ddns_principal_group        = nios_dns_ddns_principalcluster_group.create_ddns_principal_cluster_group.name
+}
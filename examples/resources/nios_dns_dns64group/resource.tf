@@ -0,0 +1,37 @@
+// Create a DNS64 Group with Basic Fields
+resource "nios_dns_dns64group" "create_dns64_group" {
+  name = "example_dns64_group"
+}
+
+// Create a DNS64 Group with Additional Fields
+resource "nios_dns_dns64group" "create_dns64_group_with_additional_fields" {
+  name                = "example_dns64_group_1"
+  comment             = "Example DNS64 Group"
+  prefix              = "2001:db8:64::/96"
+  enable_dnssec_dns64 = true
+  clients = [
+    {
+      address    = "192.168.10.0/24"
+      permission = "DENY"
+    },
+    {
+      address = "any"
+    }
+  ]
+  exclude = [
+    {
+      address = "::ffff:0:0/96"
+    }
+  ]
+  extattrs = {
+    Site = "location-1"
+  }
+}
+
+// Enable the DNS64 Group on a DNS View, the view references the group by name
+resource "nios_dns_view" "create_view_with_dns64_group" {
+  name          = "example_dns64_view"
+  use_dns64     = true
+  dns64_enabled = true
+  dns64_groups  = [nios_dns_dns64group.create_dns64_group_with_additional_fields.name]
+}
@@ -0,0 +1,19 @@
+// Create Response Policy Zones to order
+resource "nios_dns_zone_rp" "threat_feed" {
+  fqdn = "threat-feed.rpz.example.com"
+  view = "default"
+}
+
+resource "nios_dns_zone_rp" "local_overrides" {
+  fqdn = "local-overrides.rpz.example.com"
+  view = "default"
+}
+
+// Evaluate the local overrides before the threat feed
+resource "nios_dns_orderedresponsepolicyzones" "ordered_rpz" {
+  view = "default"
+  rp_zones = [
+    nios_dns_zone_rp.local_overrides.fqdn,
+    nios_dns_zone_rp.threat_feed.fqdn,
+  ]
+}
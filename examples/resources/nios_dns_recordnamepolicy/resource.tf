@@ -0,0 +1,13 @@
+// Create a Record Name Policy with Basic Fields
+resource "nios_dns_recordnamepolicy" "create_record_name_policy" {
+  name  = "example_record_name_policy"
+  regex = "^[a-z0-9][-a-z0-9]*[a-z0-9]$"
+}
+
+// Use the Record Name Policy on an authoritative zone, the zone references the policy by name
+resource "nios_dns_zone_auth" "create_zone_with_record_name_policy" {
+  fqdn                   = "example-policy.com"
+  view                   = "default"
+  use_record_name_policy = true
+  record_name_policy     = nios_dns_recordnamepolicy.create_record_name_policy.name
+}
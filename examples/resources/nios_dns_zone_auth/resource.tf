@@ -62,3 +62,33 @@ resource "nios_dns_zone_auth" "create_zone5" {
     Site = "location-1"
   }
 }
+
+// Create an Auth Zone served by a Microsoft Server
+resource "nios_dns_zone_auth" "zone_ms_primary" {
+  fqdn = "ms-example.com"
+  view = "default"
+
+  ms_primaries = [
+    {
+      address = "10.10.0.20"
+      ns_name = "ms-dns.example.com"
+      ns_ip   = "10.10.0.20"
+    }
+  ]
+}
+
+// Create an Auth Zone and warn about data discrepancies between its primary and secondary servers.
+// The check only runs when the zone is updated, using the result of the last scheduled DNS integrity check; creating the zone is not checked
+resource "nios_dns_zone_auth" "zone_warn_on_discrepancies" {
+  fqdn = "consistent-example.com"
+  view = "default"
+
+  grid_primary = [
+    {
+      name = "infoblox.10_0_0_1",
+    }
+  ]
+  dns_integrity_enable  = true
+  dns_integrity_member  = "infoblox.10_0_0_1"
+  warn_on_discrepancies = true
+}
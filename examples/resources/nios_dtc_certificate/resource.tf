@@ -0,0 +1,17 @@
+// Upload a DTC certificate from a local file
+resource "nios_dtc_certificate" "dtc_certificate_file" {
+  certificate_file_path = "${path.module}/monitor.pem"
+}
+
+// Upload a DTC certificate from PEM content
+resource "nios_dtc_certificate" "dtc_certificate_pem" {
+  certificate_pem = file("${path.module}/monitor_ca.pem")
+}
+
+// Use the certificate as the client certificate of an HTTPS health monitor
+resource "nios_dtc_monitor_http" "dtc_monitor_https" {
+  name          = "example_https_monitor"
+  secure        = true
+  client_cert   = nios_dtc_certificate.dtc_certificate_file.ref
+  validate_cert = false
+}
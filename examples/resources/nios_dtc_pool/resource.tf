@@ -72,3 +72,18 @@ resource "nios_dtc_pool" "dtc_pool3" {
     }
   ]
 }
+
+// Create a DTC pool and wait until it is healthy, e.g. before shifting traffic to it
+resource "nios_dtc_pool" "dtc_pool4" {
+  name                = "dtc_pool4"
+  lb_preferred_method = "ROUND_ROBIN"
+  monitors            = ["dtc:monitor:http/ZG5zLmlkbnNfbW9uaXRvcl9odHRwJGh0dHA:http"]
+  servers = [
+    {
+      server = nios_dtc_server.dtc_server_1.ref
+      ratio  = 100
+    }
+  ]
+  wait_for_health         = true
+  wait_for_health_timeout = 300
+}
@@ -27,3 +27,17 @@ resource "nios_dtc_server" "create_with_additional_fields" {
   sni_hostname     = "server-sni"
   use_sni_hostname = true
 }
+
+// Create a DTC Server and wait until its monitors report it as available
+resource "nios_dtc_server" "dtc_server_wait_for_health" {
+  name = "dtc-server-wait-for-health"
+  host = "3.3.3.10"
+  monitors = [
+    {
+      monitor = "dtc:monitor:icmp/ZG5zLmlkbnNfbW9uaXRvcl9pY21wJGljbXA:icmp"
+      host    = "3.3.3.10"
+    }
+  ]
+  wait_for_health         = true
+  wait_for_health_timeout = 300
+}
@@ -0,0 +1,20 @@
+// Upload a custom MaxMind GeoIP database
+resource "nios_dtc_topology_database" "geoip_database" {
+  topology_type = "GEOIP"
+  file_path     = "${path.module}/GeoLite2-City.mmdb"
+}
+
+// Upload an EA Topology database and wait up to 10 minutes for its deployment
+resource "nios_dtc_topology_database" "ea_database" {
+  topology_type = "EA"
+  file_path     = "${path.module}/ea_topology.mmdb"
+  wait_timeout  = 600
+}
+
+// Use the labels of the uploaded database in a topology rule
+data "nios_dtc_topology_label" "city_labels" {
+  filters = {
+    field = "City"
+  }
+  depends_on = [nios_dtc_topology_database.geoip_database]
+}
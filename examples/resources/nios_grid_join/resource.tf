@@ -18,7 +18,15 @@ resource "nios_grid_join" "member_join_1" {
   grid_name       = "Infoblox"
   master          = "172.28.82.32"
   shared_secret   = "secret"
-  depends_on      = [nios_grid_member.example_grid_member_1]
+
+  // Locate the member on the grid master by host name while waiting for it to come online
+  member_host_name = nios_grid_member.example_grid_member_1.host_name
+  join_timeout     = 2400
+
+  // Remove the member from the grid when this resource is destroyed
+  leave_on_destroy = true
+
+  depends_on = [nios_grid_member.example_grid_member_1]
 }
 
 // Create an Offline Member with IPV6 config
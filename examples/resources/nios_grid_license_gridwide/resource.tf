@@ -0,0 +1,8 @@
+// Install a Grid-wide license
+resource "nios_grid_license_gridwide" "security_ecosystem_license" {
+  key = var.security_ecosystem_license_key
+}
+
+variable "security_ecosystem_license_key" {
+  type = string
+}
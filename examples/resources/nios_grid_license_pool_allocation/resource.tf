@@ -0,0 +1,12 @@
+// Allocate DNS and DHCP licenses from the license pools to a vNIOS member
+resource "nios_grid_license_pool_allocation" "cloud_member_licenses" {
+  member   = "infoblox.member1"
+  licenses = ["NIOS", "DNS", "DHCP"]
+}
+
+// Allocate licenses to a newly joined member before its services are configured
+resource "nios_grid_license_pool_allocation" "joined_member_licenses" {
+  member       = nios_grid_join.member_join_1.member_host_name
+  licenses     = ["DNS", "DHCP"]
+  wait_timeout = 900
+}
@@ -0,0 +1,8 @@
+// Install a license on the member node it was issued for
+resource "nios_grid_member_license" "dns_license" {
+  key = var.member_dns_license_key
+}
+
+variable "member_dns_license_key" {
+  type = string
+}
@@ -0,0 +1,15 @@
+// Upload an upgrade image and distribute it to all members
+resource "nios_grid_upgrade" "distribute_only" {
+  image_file_path = "/path/to/nios-9.0.6-upgrade.bin"
+  test_upgrade    = true
+}
+
+// Upgrade the Grid with the image that is already distributed, one upgrade group at a time
+resource "nios_grid_upgrade" "staged_upgrade" {
+  upgrade        = true
+  upgrade_groups = ["Grid Master", "site-a", "site-b"]
+  wait_timeout   = 3600
+
+  // Revert the Grid to the previous version when this resource is destroyed
+  revert_on_destroy = true
+}
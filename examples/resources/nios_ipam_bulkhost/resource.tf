@@ -0,0 +1,35 @@
+// Manage IPAM Bulk Host with Basic Fields
+resource "nios_ipam_bulkhost" "ipam_bulkhost_basic" {
+  prefix     = "lab"
+  start_addr = "10.10.0.10"
+  end_addr   = "10.10.0.50"
+  zone       = "example.com"
+}
+
+// Create a Bulk Hostname Template (Required as Name Template)
+resource "nios_ipam_bulk_hostname_template" "ipam_bulkhost_template" {
+  template_name   = "vdi-two-octet"
+  template_format = "vdi-$3-$4"
+}
+
+// Manage IPAM Bulk Host with Additional Fields
+resource "nios_ipam_bulkhost" "ipam_bulkhost_with_additional_fields" {
+  prefix     = "vdi"
+  start_addr = "10.10.1.1"
+  end_addr   = "10.10.1.254"
+  zone       = "example.com"
+  view       = "default"
+
+  // Additional Fields
+  comment           = "VDI desktop pool"
+  reverse           = true
+  name_template     = nios_ipam_bulk_hostname_template.ipam_bulkhost_template.template_name
+  use_name_template = true
+  ttl               = 3600
+  use_ttl           = true
+
+  // Extensible Attributes
+  extattrs = {
+    Site = "location-1"
+  }
+}
@@ -0,0 +1,6 @@
+// Manage the characters of an existing Hostname Rewrite Policy
+resource "nios_ipam_hostnamerewritepolicy" "manage_hostname_rewrite_policy" {
+  name                  = "example_hostname_rewrite_policy"
+  valid_characters      = "-0123456789abcdefghijklmnopqrstuvwxyz"
+  replacement_character = "-"
+}
@@ -78,3 +78,33 @@ resource "nios_ipam_ipv6network" "example_func_call" {
     nios_ipam_ipv6network.example_network
   ]
 }
+
+// Create an IPAM IPv6 Network with a VLAN allocated using Function Call
+resource "nios_ipam_vlanview" "example_vlanview" {
+  start_vlan_id = 10
+  end_vlan_id   = 20
+  name          = "example_ipv6_vlan_view"
+}
+
+resource "nios_ipam_vlan" "example_vlan" {
+  name   = "example_ipv6_vlan"
+  parent = nios_ipam_vlanview.example_vlanview.ref
+  func_call = {
+    attribute_name  = "id"
+    object_function = "next_available_vlan_id"
+    result_field    = "vlan_ids"
+    object          = "vlanview"
+    object_parameters = {
+      name = nios_ipam_vlanview.example_vlanview.name
+    }
+  }
+}
+
+resource "nios_ipam_ipv6network" "example_vlans" {
+  network = "12::/64"
+  vlans = [
+    {
+      vlan = nios_ipam_vlan.example_vlan.ref
+    }
+  ]
+}
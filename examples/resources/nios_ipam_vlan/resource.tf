@@ -30,3 +30,18 @@ resource "nios_ipam_vlan" "ipam_vlan_with_additional_fields" {
     Site = "location-1"
   }
 }
+
+// Manage IPAM Vlan with Next Available VLAN ID using Function Call
+resource "nios_ipam_vlan" "ipam_vlan_func_call" {
+  name   = "example_vlan_func_call"
+  parent = nios_ipam_vlanview.ipam_vlanview_parent.ref
+  func_call = {
+    attribute_name  = "id"
+    object_function = "next_available_vlan_id"
+    result_field    = "vlan_ids"
+    object          = "vlanview"
+    object_parameters = {
+      name = nios_ipam_vlanview.ipam_vlanview_parent.name
+    }
+  }
+}
@@ -0,0 +1,26 @@
+// Create a Microsoft Server (Required as parent)
+resource "nios_microsoft_msserver" "example_msserver" {
+  address    = "10.10.0.10"
+  login_name = "example_login"
+}
+
+// Manage the DHCP service of a Microsoft Server with Basic Fields
+resource "nios_microsoft_msserver_dhcp" "msserver_dhcp_basic" {
+  address = nios_microsoft_msserver.example_msserver.address
+}
+
+// Manage the DHCP service of a Microsoft Server with Additional Fields
+resource "nios_microsoft_msserver_dhcp" "msserver_dhcp_with_additional_fields" {
+  address = nios_microsoft_msserver.example_msserver.address
+
+  // Override the synchronization interval of the Microsoft Server
+  synchronization_interval     = 5
+  use_synchronization_interval = true
+
+  // Override the login credentials of the Microsoft Server
+  login_name     = "dhcp_login"
+  login_password = "Example-Password1"
+  use_login      = true
+
+  next_sync_control = "START"
+}
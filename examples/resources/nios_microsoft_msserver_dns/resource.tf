@@ -0,0 +1,27 @@
+// Create a Microsoft Server (Required as parent)
+resource "nios_microsoft_msserver" "example_msserver" {
+  address    = "10.10.0.20"
+  login_name = "example_login"
+}
+
+// Manage the DNS service of a Microsoft Server with Basic Fields
+resource "nios_microsoft_msserver_dns" "msserver_dns_basic" {
+  address = nios_microsoft_msserver.example_msserver.address
+}
+
+// Manage the DNS service of a Microsoft Server with Additional Fields
+resource "nios_microsoft_msserver_dns" "msserver_dns_with_additional_fields" {
+  address = nios_microsoft_msserver.example_msserver.address
+
+  // Override the synchronization interval of the Microsoft Server
+  synchronization_interval     = 10
+  use_synchronization_interval = true
+
+  enable_dns_reports_sync     = true
+  use_enable_dns_reports_sync = true
+
+  // Override the login credentials of the Microsoft Server
+  login_name     = "dns_login"
+  login_password = "Example-Password1"
+  use_login      = true
+}
@@ -0,0 +1,45 @@
+// Create Parent RP Zone
+resource "nios_dns_zone_rp" "parent_zone" {
+  fqdn = "rpz.example.com"
+}
+
+// Create RPZ Rule Set - Block Domain Names (No Such Domain) from a file with one domain name per line
+resource "nios_rpz_ruleset" "block_list" {
+  rp_zone = nios_dns_zone_rp.parent_zone.fqdn
+  trigger = "NAME"
+  policy  = "NXDOMAIN"
+  entries = compact(split("\n", file("${path.module}/block_list.txt")))
+}
+
+// Create RPZ Rule Set - Passthru Domain Names
+resource "nios_rpz_ruleset" "allow_list" {
+  rp_zone = nios_dns_zone_rp.parent_zone.fqdn
+  trigger = "NAME"
+  policy  = "PASSTHRU"
+  entries = ["intranet.example.com", "*.partner.example.com"]
+}
+
+// Create RPZ Rule Set - Substitute Domain Names
+resource "nios_rpz_ruleset" "walled_garden" {
+  rp_zone         = nios_dns_zone_rp.parent_zone.fqdn
+  trigger         = "NAME"
+  policy          = "SUBSTITUTE"
+  substitute_name = "walled-garden.example.com"
+  entries         = ["malware1.example.net", "malware2.example.net"]
+}
+
+// Create RPZ Rule Set - Block IP Addresses and Networks (No Data)
+resource "nios_rpz_ruleset" "ip_block_list" {
+  rp_zone = nios_dns_zone_rp.parent_zone.fqdn
+  trigger = "IP_ADDRESS"
+  policy  = "NODATA"
+  entries = ["11.0.0.1", "11.0.1.0/24"]
+}
+
+// Create RPZ Rule Set - Passthru Client IP Networks
+resource "nios_rpz_ruleset" "client_allow_list" {
+  rp_zone = nios_dns_zone_rp.parent_zone.fqdn
+  trigger = "CLIENT_IP_ADDRESS"
+  policy  = "PASSTHRU"
+  entries = ["12.0.0.0/24"]
+}
@@ -0,0 +1,33 @@
+// Create AD Auth Service with Basic Fields
+resource "nios_security_ad_auth_service" "ad_authservice_with_basic_fields" {
+  name      = "example_ad_authservice"
+  ad_domain = "corp.example.com"
+  domain_controllers = [
+    {
+      fqdn_or_ip = "10.0.0.10"
+    }
+  ]
+}
+
+// Create AD Auth Service with Additional Fields
+resource "nios_security_ad_auth_service" "ad_authservice_with_additional_fields" {
+  name                  = "example_ad_authservice2"
+  ad_domain             = "corp.example.com"
+  comment               = "Example AD Auth Service"
+  disabled              = false
+  nested_group_querying = true
+  timeout               = 10
+  domain_controllers = [
+    {
+      fqdn_or_ip = "dc1.corp.example.com"
+      auth_port  = 636
+      encryption = "SSL"
+      comment    = "Primary domain controller"
+    },
+    {
+      fqdn_or_ip = "dc2.corp.example.com"
+      auth_port  = 389
+      encryption = "NONE"
+    }
+  ]
+}
@@ -34,3 +34,11 @@ resource "nios_security_admin_user" "admin_user_additional_fields" {
   ]
   use_ssh_keys = true
 }
+
+// Create an Admin User whose password is set again when it expires within 14 days
+resource "nios_security_admin_user" "admin_user_password_rotation" {
+  name                   = "example_service_account"
+  password               = "Example-password1!"
+  admin_groups           = ["admin-group"]
+  password_rotation_days = 14
+}
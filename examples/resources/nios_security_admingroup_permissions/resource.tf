@@ -0,0 +1,49 @@
+// Create an Admin Group (Required as parent)
+resource "nios_security_admin_group" "example_admin_group" {
+  name = "dns-operators"
+}
+
+// Create a View (Required as parent)
+resource "nios_dns_view" "example_view" {
+  name = "operators_view"
+}
+
+// Manage the complete set of permissions of the Admin Group
+resource "nios_security_admingroup_permissions" "example_admin_group_permissions" {
+  group = nios_security_admin_group.example_admin_group.name
+
+  permissions = [
+    // Global permission on a resource type
+    {
+      resource_type = "NETWORK_VIEW"
+      permission    = "READ"
+    },
+    // Permission on an object, addressed by reference
+    {
+      object        = nios_dns_view.example_view.ref
+      resource_type = "ZONE"
+      permission    = "WRITE"
+    },
+    // Permission on an object, looked up by name
+    {
+      object_type   = "zone_auth"
+      object_name   = "example.com"
+      object_view   = "default"
+      resource_type = "A"
+      permission    = "WRITE"
+    },
+  ]
+}
+
+// Manage the complete set of permissions of an Admin Role
+resource "nios_security_admingroup_permissions" "example_admin_role_permissions" {
+  role = "DNS Admin"
+
+  permissions = [
+    {
+      object_type = "view"
+      object_name = "default"
+      permission  = "READ"
+    },
+  ]
+}
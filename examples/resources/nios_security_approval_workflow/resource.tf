@@ -0,0 +1,33 @@
+// Create the admin groups used by the Approval Workflow
+resource "nios_security_admin_group" "submitter_group" {
+  name = "example_submitter_group"
+}
+
+resource "nios_security_admin_group" "approval_group" {
+  name = "example_approval_group"
+}
+
+// Create an Approval Workflow with Basic Fields
+resource "nios_security_approval_workflow" "approval_workflow_basic_fields" {
+  submitter_group = nios_security_admin_group.submitter_group.name
+  approval_group  = nios_security_admin_group.approval_group.name
+}
+
+// Create an Approval Workflow with Additional Fields
+resource "nios_security_admin_group" "submitter_group2" {
+  name = "example_submitter_group2"
+}
+
+resource "nios_security_approval_workflow" "approval_workflow_additional_fields" {
+  submitter_group        = nios_security_admin_group.submitter_group2.name
+  approval_group         = nios_security_admin_group.approval_group.name
+  ticket_number          = "REQUIRED"
+  submitter_comment      = "REQUIRED"
+  approver_comment       = "NOTUSED"
+  enable_approval_notify = true
+  enable_failed_notify   = true
+  enable_notify_group    = true
+  extattrs = {
+    Site = "location-1"
+  }
+}
@@ -0,0 +1,24 @@
+// Retrieve the Local User Authentication Service
+data "nios_security_localuser_authservice" "local" {}
+
+// Create an AD Auth Service to use in the Authentication Policy
+resource "nios_security_ad_auth_service" "ad_authservice" {
+  name      = "example_ad_authservice"
+  ad_domain = "corp.example.com"
+  domain_controllers = [
+    {
+      fqdn_or_ip = "10.0.0.10"
+    }
+  ]
+}
+
+// Manage the Authentication Policy: try local users first, then Active Directory
+resource "nios_security_authpolicy" "authpolicy" {
+  auth_services = [
+    data.nios_security_localuser_authservice.local.result[0].ref,
+    nios_security_ad_auth_service.ad_authservice.ref,
+  ]
+  admin_groups  = ["admin-group"]
+  default_group = "admin-group"
+  usage_type    = "FULL"
+}
@@ -0,0 +1,9 @@
+// Upload a trusted CA Certificate from a local file
+resource "nios_security_cacertificate" "cacertificate_file" {
+  certificate_file_path = "${path.module}/ca.pem"
+}
+
+// Upload a trusted CA Certificate from PEM content
+resource "nios_security_cacertificate" "cacertificate_content" {
+  certificate = file("${path.module}/intermediate_ca.pem")
+}
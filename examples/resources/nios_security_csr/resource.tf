@@ -0,0 +1,34 @@
+// Generate a key pair and a CSR for the HTTPS certificate of the Grid Master
+resource "nios_security_csr" "csr" {
+  member            = "infoblox.localdomain"
+  certificate_usage = "ADMIN"
+  cn                = "infoblox.localdomain"
+  org               = "Example"
+  country           = "US"
+  key_size          = 4096
+  subject_alternative_names = [
+    {
+      type  = "DNS"
+      value = "gm.example.com"
+    }
+  ]
+}
+
+// Generate a new key pair and CSR every 60 days, ahead of the renewal of a certificate valid for 90 days
+resource "time_rotating" "csr_rotation" {
+  rotation_days = 60
+}
+
+resource "nios_security_csr" "csr_rotated" {
+  member = "member.example.com"
+  cn     = "member.example.com"
+  triggers = {
+    rotation = time_rotating.csr_rotation.id
+  }
+}
+
+// Write the generated CSR to a file to get it signed by a CA
+resource "local_file" "csr" {
+  content  = nios_security_csr.csr.csr
+  filename = "${path.module}/infoblox.csr"
+}
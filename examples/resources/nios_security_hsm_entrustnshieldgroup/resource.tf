@@ -0,0 +1,42 @@
+variable "nshield_softcard_pass_phrase" {
+  type      = string
+  sensitive = true
+}
+
+// Create an Entrust nShield HSM group with required fields
+resource "nios_security_hsm_entrustnshieldgroup" "entrustnshieldgroup_required_fields" {
+  name          = "example_entrustnshieldgroup"
+  key_server_ip = "10.0.0.40"
+  protection    = "MODULE"
+  entrustnshield_hsm = [
+    {
+      remote_ip  = "10.0.0.41"
+      remote_esn = "ABCD-1234-EF56"
+      keyhash    = "0123456789abcdef0123456789abcdef01234567"
+    }
+  ]
+}
+
+// Create an Entrust nShield HSM group protected by a softcard
+resource "nios_security_hsm_entrustnshieldgroup" "entrustnshieldgroup_softcard" {
+  name            = "example_entrustnshieldgroup_softcard"
+  key_server_ip   = "10.0.0.40"
+  key_server_port = 9004
+  protection      = "SOFTCARD"
+  card_name       = "dnssec-softcard"
+  pass_phrase     = var.nshield_softcard_pass_phrase
+  comment         = "Softcard protected nShield group for DNSSEC keys"
+  entrustnshield_hsm = [
+    {
+      remote_ip  = "10.0.0.41"
+      remote_esn = "ABCD-1234-EF56"
+      keyhash    = "0123456789abcdef0123456789abcdef01234567"
+    },
+    {
+      remote_ip   = "10.0.0.42"
+      remote_port = 9005
+      remote_esn  = "ABCD-5678-EF90"
+      keyhash     = "fedcba9876543210fedcba9876543210fedcba98"
+    }
+  ]
+}
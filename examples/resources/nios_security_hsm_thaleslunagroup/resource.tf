@@ -0,0 +1,39 @@
+variable "luna_partition_password" {
+  type      = string
+  sensitive = true
+}
+
+// Create an HSM Thales Luna group with required fields
+resource "nios_security_hsm_thaleslunagroup" "thaleslunagroup_required_fields" {
+  name        = "example_thaleslunagroup"
+  hsm_version = "Luna_7_CPL"
+  pass_phrase = var.luna_partition_password
+  thalesluna = [
+    {
+      name                    = "10.0.0.31"
+      partition_serial_number = "1234567"
+      server_cert_file_path   = "${path.module}/luna1_server.pem"
+    }
+  ]
+}
+
+// Create an HSM Thales Luna group with additional fields
+resource "nios_security_hsm_thaleslunagroup" "thaleslunagroup_additional_fields" {
+  name        = "example_thaleslunagroup_ha"
+  hsm_version = "Luna_7_CPL"
+  pass_phrase = var.luna_partition_password
+  comment     = "HA Thales Luna group for DNSSEC keys"
+  thalesluna = [
+    {
+      name                    = "luna1.example.com"
+      partition_serial_number = "1234567"
+      server_cert_file_path   = "${path.module}/luna1_server.pem"
+    },
+    {
+      name                    = "luna2.example.com"
+      partition_serial_number = "7654321"
+      server_cert_file_path   = "${path.module}/luna2_server.pem"
+      disable                 = true
+    }
+  ]
+}
@@ -0,0 +1,12 @@
+// Install the HTTPS certificate signed for a CSR generated on the member
+resource "nios_security_https_certificate" "https_certificate_csr" {
+  member                = "infoblox.localdomain"
+  certificate_file_path = "${path.module}/infoblox.pem"
+}
+
+// Install an HTTPS certificate with a private key generated outside NIOS, e.g. a certificate renewed by an ACME client
+resource "nios_security_https_certificate" "https_certificate_key" {
+  member                = "member.example.com"
+  certificate_file_path = "/etc/letsencrypt/live/member.example.com/fullchain.pem"
+  private_key           = file("/etc/letsencrypt/live/member.example.com/privkey.pem")
+}
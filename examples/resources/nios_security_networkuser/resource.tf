@@ -0,0 +1,18 @@
+// Create Network User with Basic Fields
+resource "nios_security_networkuser" "networkuser_with_basic_fields" {
+  name       = "jdoe"
+  domainname = "corp.example.com"
+  address    = "10.0.0.25"
+}
+
+// Create Network User with Additional Fields
+resource "nios_security_networkuser" "networkuser_with_additional_fields" {
+  name            = "asmith"
+  domainname      = "corp.example.com"
+  address         = "10.0.0.26"
+  network_view    = "default"
+  guid            = "a1b2c3d4-0000-1111-2222-333344445555"
+  logon_id        = "asmith-logon"
+  first_seen_time = 1760000000
+  last_seen_time  = 1760003600
+}
@@ -0,0 +1,13 @@
+// Update the User Profile of the authenticated admin with Basic Fields
+resource "nios_security_userprofile" "userprofile_with_basic_fields" {
+  email = "admin@example.com"
+}
+
+// Update the User Profile of the authenticated admin with Additional Fields
+resource "nios_security_userprofile" "userprofile_with_additional_fields" {
+  email               = "admin@example.com"
+  table_size          = 50
+  global_search_on_ea = true
+  time_zone           = "(UTC + 1:00) Amsterdam"
+  use_time_zone       = true
+}
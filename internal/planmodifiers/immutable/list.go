@@ -0,0 +1,48 @@
+package immutable
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var _ planmodifier.List = immutableList{}
+
+// immutableList validates that the provided list is not mutated after resource creation.
+// Unlike the other immutable modifiers, setting or removing the list after creation is also rejected.
+type immutableList struct{}
+
+func (m immutableList) Description(ctx context.Context) string {
+	return "Ensures this attribute cannot be changed after resource creation"
+}
+
+func (m immutableList) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m immutableList) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	// Resource is being created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.StateValue.IsUnknown() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if req.StateValue.Equal(req.PlanValue) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Immutable Attribute Changed",
+		"The attribute cannot be changed after creation. "+
+			"To change this value, the resource must be destroyed and recreated.",
+	)
+}
+
+// ImmutableList returns a plan modifier that ensures the given list attribute cannot be changed after creation.
+func ImmutableList() planmodifier.List {
+	return immutableList{}
+}
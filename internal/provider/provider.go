@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -27,6 +28,7 @@ import (
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/discovery"
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/dns"
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/dtc"
+	"github.com/infobloxopen/terraform-provider-nios/internal/service/federatedrealms"
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/grid"
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/ipam"
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/microsoft"
@@ -37,6 +39,7 @@ import (
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/rpz"
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/security"
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/smartfolder"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
 // Ensure NIOSProvider satisfies various provider interfaces.
@@ -54,13 +57,14 @@ type NIOSProvider struct {
 
 // NIOSProviderModel describes the provider data model.
 type NIOSProviderModel struct {
-	NIOSHostURL        types.String `tfsdk:"nios_host_url"`
-	NIOSUsername       types.String `tfsdk:"nios_username"`
-	NIOSPassword       types.String `tfsdk:"nios_password"`
-	ProxyURL           types.String `tfsdk:"proxy_url"`
-	ProxySearch        types.String `tfsdk:"proxy_search"`
-	RetryTimeout       types.Int64  `tfsdk:"retry_timeout"`
-	ManageInternalIdEA types.Bool   `tfsdk:"manage_internal_id_ea"`
+	NIOSHostURL         types.String `tfsdk:"nios_host_url"`
+	NIOSUsername        types.String `tfsdk:"nios_username"`
+	NIOSPassword        types.String `tfsdk:"nios_password"`
+	ProxyURL            types.String `tfsdk:"proxy_url"`
+	ProxySearch         types.String `tfsdk:"proxy_search"`
+	RetryTimeout        types.Int64  `tfsdk:"retry_timeout"`
+	ManageInternalIdEA  types.Bool   `tfsdk:"manage_internal_id_ea"`
+	ApprovalWaitTimeout types.Int64  `tfsdk:"approval_wait_timeout"`
 }
 
 func (p *NIOSProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -103,6 +107,13 @@ func (p *NIOSProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional:    true,
 				Description: "Determines whether the provider manages the Terraform Internal ID extensible attribute in NIOS. This attribute is required by the provider to store the Terraform resource ID corresponding to NIOS objects. When true, the provider ensures the attribute exists and manages its lifecycle. When false, the provider does not validate, create, update, or otherwise manage the attribute. Default value: true",
 			},
+			"approval_wait_timeout": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "Specifies how long (in seconds) to wait for changes that NIOS submits for approval to be approved and executed. When not set or 0, a change submitted for approval fails with the ID of the scheduled task instead of being reported as applied.",
+			},
 		},
 	}
 }
@@ -125,6 +136,32 @@ func (p *NIOSProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		option.WithProxyURL(data.ProxyURL.ValueString()),
 	)
 
+	// Recognise changes that are submitted for approval instead of being applied
+	approvalWaitTimeout := time.Duration(data.ApprovalWaitTimeout.ValueInt64()) * time.Second
+	for _, httpClient := range []*http.Client{
+		client.ACLAPI.Cfg.HTTPClient,
+		client.CloudAPI.Cfg.HTTPClient,
+		client.DHCPAPI.Cfg.HTTPClient,
+		client.DiscoveryAPI.Cfg.HTTPClient,
+		client.DNSAPI.Cfg.HTTPClient,
+		client.DTCAPI.Cfg.HTTPClient,
+		client.FederatedRealmsAPI.Cfg.HTTPClient,
+		client.GridAPI.Cfg.HTTPClient,
+		client.IPAMAPI.Cfg.HTTPClient,
+		client.MicrosoftAPI.Cfg.HTTPClient,
+		client.MiscAPI.Cfg.HTTPClient,
+		client.NotificationAPI.Cfg.HTTPClient,
+		client.ParentalControlAPI.Cfg.HTTPClient,
+		client.RIRAPI.Cfg.HTTPClient,
+		client.RPZAPI.Cfg.HTTPClient,
+		client.SecurityAPI.Cfg.HTTPClient,
+		client.SmartFolderAPI.Cfg.HTTPClient,
+		client.ThreatInsightAPI.Cfg.HTTPClient,
+		client.ThreatProtectionAPI.Cfg.HTTPClient,
+	} {
+		utils.WithApprovalHandling(httpClient, approvalWaitTimeout, terraformInternalIDEA)
+	}
+
 	// Set ProxySearch configuration
 	config.SetProxySearch(data.ProxySearch.ValueString())
 
@@ -181,6 +218,8 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		dns.NewZoneDelegatedResource,
 		dns.NewZoneAuthResource,
 		dns.NewZoneRpResource,
+		dns.NewOrderedresponsepolicyzonesResource,
+		dns.NewCopyzonerecordsResource,
 		dns.NewViewResource,
 		dns.NewZoneStubResource,
 		dns.NewNsgroupResource,
@@ -188,6 +227,10 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		dns.NewNsgroupForwardingmemberResource,
 		dns.NewNsgroupForwardstubserverResource,
 		dns.NewNsgroupStubmemberResource,
+		dns.NewDns64groupResource,
+		dns.NewDdnsPrincipalclusterResource,
+		dns.NewDdnsPrincipalclusterGroupResource,
+		dns.NewRecordnamepolicyResource,
 		dns.NewIPAllocationResource,
 		dns.NewIPAssociationResource,
 		dns.NewSharedrecordgroupResource,
@@ -222,11 +265,14 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		dhcp.NewIpv6filteroptionResource,
 		dhcp.NewFilterrelayagentResource,
 		dhcp.NewFilteroptionResource,
+		dhcp.NewOrderedrangesResource,
 
 		dtc.NewDtcLbdnResource,
 		dtc.NewDtcServerResource,
 		dtc.NewDtcPoolResource,
 		dtc.NewDtcTopologyResource,
+		dtc.NewDtcTopologyDatabaseResource,
+		dtc.NewDtcCertificateResource,
 		dtc.NewDtcMonitorSnmpResource,
 		dtc.NewDtcMonitorHttpResource,
 		dtc.NewDtcMonitorTcpResource,
@@ -246,11 +292,13 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		ipam.NewIpv6networkResource,
 		ipam.NewNetworkviewResource,
 		ipam.NewBulkhostnametemplateResource,
+		ipam.NewHostnamerewritepolicyResource,
 		ipam.NewVlanviewResource,
 		ipam.NewVlanResource,
 		ipam.NewVlanrangeResource,
 		ipam.NewSuperhostResource,
 		ipam.NewIpv6networktemplateResource,
+		ipam.NewBulkhostResource,
 
 		cloud.NewAwsrte53taskgroupResource,
 		cloud.NewAwsuserResource,
@@ -258,12 +306,23 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		security.NewAdminuserResource,
 		security.NewAdmingroupResource,
 		security.NewPermissionResource,
+		security.NewAdmingroupPermissionsResource,
 		security.NewAdminroleResource,
+		security.NewApprovalworkflowResource,
 		security.NewFtpuserResource,
 		security.NewSnmpuserResource,
 		security.NewCertificateAuthserviceResource,
 		security.NewSamlAuthserviceResource,
 		security.NewLdapAuthServiceResource,
+		security.NewAdAuthServiceResource,
+		security.NewAuthpolicyResource,
+		security.NewCacertificateResource,
+		security.NewCsrResource,
+		security.NewHttpsCertificateResource,
+		security.NewHsmThaleslunagroupResource,
+		security.NewHsmEntrustnshieldgroupResource,
+		security.NewNetworkuserResource,
+		security.NewUserprofileResource,
 		security.NewTacacsplusAuthserviceResource,
 		security.NewRadiusAuthserviceResource,
 
@@ -286,6 +345,10 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		grid.NewMemberResource,
 		grid.NewUpgradescheduleResource,
 		grid.NewGridJoinResource,
+		grid.NewGridUpgradeResource,
+		grid.NewMemberLicenseResource,
+		grid.NewLicenseGridwideResource,
+		grid.NewGridLicensePoolAllocationResource,
 
 		discovery.NewDiscoveryCredentialgroupResource,
 		discovery.NewVdiscoverytaskResource,
@@ -307,6 +370,7 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		rpz.NewRecordRpzCnameClientipaddressResource,
 		rpz.NewRecordRpzCnameIpaddressdnResource,
 		rpz.NewRecordRpzCnameClientipaddressdnResource,
+		rpz.NewRpzRulesetResource,
 
 		rir.NewRirOrganizationResource,
 
@@ -317,6 +381,8 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 
 		microsoft.NewMsserverResource,
 		microsoft.NewMsserverAdsitesSiteResource,
+		microsoft.NewMsserverDhcpResource,
+		microsoft.NewMsserverDnsResource,
 		microsoft.NewMssuperscopeResource,
 	}
 }
@@ -338,10 +404,19 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		dns.NewRecordTlsaDataSource,
 		dns.NewRecordCaaDataSource,
 		dns.NewRecordUnknownDataSource,
+		dns.NewRecordDhcidDataSource,
+		dns.NewRecordDnskeyDataSource,
+		dns.NewRecordDsDataSource,
+		dns.NewRecordNsecDataSource,
+		dns.NewRecordNsec3DataSource,
+		dns.NewRecordNsec3paramDataSource,
+		dns.NewRecordRrsigDataSource,
 		dns.NewZoneForwardDataSource,
 		dns.NewZoneDelegatedDataSource,
 		dns.NewZoneAuthDataSource,
+		dns.NewZoneAuthDiscrepancyDataSource,
 		dns.NewZoneRpDataSource,
+		dns.NewOrderedresponsepolicyzonesDataSource,
 		dns.NewViewDataSource,
 		dns.NewZoneStubDataSource,
 		dns.NewNsgroupDataSource,
@@ -349,6 +424,11 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		dns.NewNsgroupForwardingmemberDataSource,
 		dns.NewNsgroupForwardstubserverDataSource,
 		dns.NewNsgroupStubmemberDataSource,
+		dns.NewDns64groupDataSource,
+		dns.NewDdnsPrincipalclusterDataSource,
+		dns.NewDdnsPrincipalclusterGroupDataSource,
+		dns.NewRecordnamepolicyDataSource,
+		dns.NewHostnamePolicyCheckDataSource,
 		dns.NewRecordHostDataSource,
 		dns.NewSharedrecordgroupDataSource,
 		dns.NewSharedrecordTxtDataSource,
@@ -382,12 +462,16 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		dhcp.NewIpv6filteroptionDataSource,
 		dhcp.NewFilterrelayagentDataSource,
 		dhcp.NewFilteroptionDataSource,
+		dhcp.NewOrderedrangesDataSource,
 
 		dtc.NewDtcLbdnDataSource,
 		dtc.NewDtcServerDataSource,
 		dtc.NewDtcPoolDataSource,
+		dtc.NewDtcStatusDataSource,
 		dtc.NewDtcTopologyRuleDataSource,
 		dtc.NewDtcTopologyDataSource,
+		dtc.NewDtcTopologyLabelDataSource,
+		dtc.NewDtcCertificateDataSource,
 		dtc.NewDtcMonitorSnmpDataSource,
 		dtc.NewDtcMonitorHttpDataSource,
 		dtc.NewDtcMonitorTcpDataSource,
@@ -407,16 +491,19 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		ipam.NewIpv6networkDataSource,
 		ipam.NewNetworkviewDataSource,
 		ipam.NewBulkhostnametemplateDataSource,
+		ipam.NewHostnamerewritepolicyDataSource,
 		ipam.NewVlanviewDataSource,
 		ipam.NewVlanDataSource,
 		ipam.NewVlanrangeDataSource,
 		ipam.NewSuperhostDataSource,
 		ipam.NewIpv6networktemplateDataSource,
+		ipam.NewBulkhostDataSource,
 
 		cloud.NewAwsrte53taskgroupDataSource,
 		cloud.NewAwsuserDataSource,
 
 		security.NewAdminroleDataSource,
+		security.NewApprovalworkflowDataSource,
 		security.NewAdminuserDataSource,
 		security.NewAdmingroupDataSource,
 		security.NewFtpuserDataSource,
@@ -425,6 +512,15 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		security.NewCertificateAuthserviceDataSource,
 		security.NewSamlAuthserviceDataSource,
 		security.NewLdapAuthServiceDataSource,
+		security.NewAdAuthServiceDataSource,
+		security.NewLocaluserAuthserviceDataSource,
+		security.NewCacertificateDataSource,
+		security.NewHttpsCertificateDataSource,
+		security.NewHsmThaleslunagroupDataSource,
+		security.NewHsmEntrustnshieldgroupDataSource,
+		security.NewHsmAllgroupsDataSource,
+		security.NewNetworkuserDataSource,
+		security.NewUserprofileDataSource,
 		security.NewTacacsplusAuthserviceDataSource,
 		security.NewRadiusAuthserviceDataSource,
 
@@ -442,10 +538,17 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		grid.NewNatgroupDataSource,
 		grid.NewExtensibleattributedefDataSource,
 		grid.NewUpgradegroupDataSource,
+		grid.NewGridMaxminddbinfoDataSource,
+		grid.NewGridX509certificateDataSource,
 		grid.NewGridServicerestartGroupDataSource,
 		grid.NewDistributionscheduleDataSource,
 		grid.NewMemberDataSource,
 		grid.NewUpgradescheduleDataSource,
+		grid.NewUpgradestatusDataSource,
+		grid.NewMemberLicenseDataSource,
+		grid.NewLicenseGridwideDataSource,
+		grid.NewGridLicensePoolDataSource,
+		grid.NewGridLicensePoolContainerDataSource,
 
 		discovery.NewDiscoveryCredentialgroupDataSource,
 		discovery.NewVdiscoverytaskDataSource,
@@ -469,6 +572,9 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		rpz.NewRecordRpzCnameClientipaddressdnDataSource,
 
 		rir.NewRirOrganizationDataSource,
+		rir.NewRirDataSource,
+
+		federatedrealms.NewFederatedrealmsDataSource,
 
 		parentalcontrol.NewParentalcontrolAvpDataSource,
 		parentalcontrol.NewParentalcontrolBlockingpolicyDataSource,
@@ -477,6 +583,9 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 
 		microsoft.NewMsserverDataSource,
 		microsoft.NewMsserverAdsitesSiteDataSource,
+		microsoft.NewMsserverAdsitesDomainDataSource,
+		microsoft.NewMsserverDhcpDataSource,
+		microsoft.NewMsserverDnsDataSource,
 		microsoft.NewMssuperscopeDataSource,
 	}
 }
@@ -530,6 +639,7 @@ func (p *NIOSProvider) ListResources(ctx context.Context) []func() list.ListReso
 		ipam.NewVlanviewList,
 		ipam.NewNetworktemplateList,
 		ipam.NewSuperhostList,
+		ipam.NewBulkhostList,
 	}
 }
 
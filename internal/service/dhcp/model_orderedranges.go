@@ -0,0 +1,103 @@
+package dhcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type OrderedrangesModel struct {
+	Ref     types.String `tfsdk:"ref"`
+	Network types.String `tfsdk:"network"`
+	Ranges  types.List   `tfsdk:"ranges"`
+}
+
+var OrderedrangesAttrTypes = map[string]attr.Type{
+	"ref":     types.StringType,
+	"network": types.StringType,
+	"ranges":  types.ListType{ElemType: types.StringType},
+}
+
+var OrderedrangesResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"network": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The reference to the network that contains the ranges.",
+	},
+	"ranges": schema.ListAttribute{
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(customvalidator.ValidateTrimmedString()),
+		},
+		MarkdownDescription: "The references to all DHCP ranges of the network, in the order in which they are evaluated. The first range is evaluated first.",
+	},
+}
+
+func (m *OrderedrangesModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dhcp.Orderedranges {
+	if m == nil {
+		return nil
+	}
+	to := &dhcp.Orderedranges{
+		Ranges: flex.ExpandFrameworkListString(ctx, m.Ranges, diags),
+	}
+	return to
+}
+
+func FlattenOrderedranges(ctx context.Context, from *dhcp.Orderedranges, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(OrderedrangesAttrTypes)
+	}
+	m := OrderedrangesModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, OrderedrangesAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *OrderedrangesModel) Flatten(ctx context.Context, from *dhcp.Orderedranges, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = OrderedrangesModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Network = flex.FlattenStringPointer(from.Network)
+	m.Ranges = flex.FlattenFrameworkListString(ctx, from.Ranges, diags)
+}
+
+// rangeName returns the readable part of a range reference, e.g. "10.0.0.10/10.0.0.20/default"
+// for "range/ZG5zLmRoY3BfcmFuZ2UkMTAuMC4wLjEwLzEwLjAuMC4yMC8vLzAv:10.0.0.10/10.0.0.20/default".
+func rangeName(ref string) string {
+	if _, name, ok := strings.Cut(ref, ":"); ok {
+		return name
+	}
+	return ref
+}
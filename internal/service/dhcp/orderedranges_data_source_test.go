@@ -0,0 +1,57 @@
+package dhcp_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccOrderedrangesDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dhcp_orderedranges.test"
+	resourceName := "nios_dhcp_orderedranges.test"
+	var v dhcp.Orderedranges
+	networkPrefix := fmt.Sprintf("10.%d.%d", acctest.RandomNumber(255), acctest.RandomNumber(255))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderedrangesDataSourceConfigFilters(networkPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckOrderedrangesExists(context.Background(), resourceName, &v),
+					}, testAccCheckOrderedrangesResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckOrderedrangesResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "network", dataSourceName, "result.0.network"),
+		resource.TestCheckResourceAttrPair(resourceName, "ranges", dataSourceName, "result.0.ranges"),
+	}
+}
+
+func testAccOrderedrangesDataSourceConfigFilters(networkPrefix string) string {
+	config := `
+data "nios_dhcp_orderedranges" "test" {
+  filters = {
+    network = nios_dhcp_orderedranges.test.network
+  }
+}
+`
+	return strings.Join([]string{testAccOrderedrangesBasicConfig(networkPrefix, "range1", "range2"), config}, "")
+}
@@ -0,0 +1,284 @@
+package dhcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForOrderedranges = "network,ranges"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrderedrangesResource{}
+var _ resource.ResourceWithImportState = &OrderedrangesResource{}
+
+func NewOrderedrangesResource() resource.Resource {
+	return &OrderedrangesResource{}
+}
+
+// OrderedrangesResource defines the resource implementation.
+type OrderedrangesResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *OrderedrangesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_orderedranges"
+}
+
+func (r *OrderedrangesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the order in which the DHCP ranges of a network are evaluated, e.g. for failover association and MAC filter matching. The list must contain every range of the network, " +
+			"ranges that are reordered, added or removed outside of Terraform are shown as changes. Destroying the resource only removes it from the Terraform state, the Grid keeps the order.",
+		Attributes: OrderedrangesResourceSchemaAttributes,
+	}
+}
+
+func (r *OrderedrangesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrderedrangesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrderedrangesModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, _, err := r.client.DHCPAPI.
+		OrderedrangesAPI.
+		List(ctx).
+		Filters(map[string]any{"network": data.Network.ValueString()}).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForOrderedranges).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Orderedranges, got error: %s", err))
+		return
+	}
+
+	list := listResp.ListOrderedrangesResponseObject.GetResult()
+
+	if len(list) == 0 {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("No Orderedranges object exists for network %s", data.Network.ValueString()))
+		return
+	}
+
+	// Each network has exactly one ordered list of ranges
+	data.Ref = flex.FlattenStringPointer(list[0].Ref)
+
+	r.update(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderedrangesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrderedrangesModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *dhcp.GetOrderedrangesResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.DHCPAPI.
+			OrderedrangesAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForOrderedranges).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Orderedranges, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetOrderedrangesResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderedrangesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrderedrangesModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderedrangesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The order of the ranges cannot be deleted, so just clear state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *OrderedrangesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
+
+// update sets the planned order of the ranges referenced by data.Ref.
+func (r *OrderedrangesResource) update(ctx context.Context, data *OrderedrangesModel, diags *diag.Diagnostics) {
+	payload := data.Expand(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Ranges created in the same apply exist by now, so the list is checked against the ranges of the network
+	// to report missing or unknown ranges instead of failing with a generic WAPI error.
+	r.checkRanges(ctx, data.Ref.ValueString(), payload.Ranges, diags)
+	if diags.HasError() {
+		return
+	}
+
+	var apiRes *dhcp.UpdateOrderedrangesResponse
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.DHCPAPI.
+			OrderedrangesAPI.
+			Update(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+			Orderedranges(*payload).
+			ReturnFieldsPlus(readableAttributesForOrderedranges).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update Orderedranges, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateOrderedrangesResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, diags)
+}
+
+// checkRanges verifies that ranges contains every range of the network ordered by ref and nothing else.
+func (r *OrderedrangesResource) checkRanges(ctx context.Context, ref string, ranges []string, diags *diag.Diagnostics) {
+	apiRes, _, err := r.client.DHCPAPI.
+		OrderedrangesAPI.
+		Read(ctx, utils.ExtractResourceRef(ref)).
+		ReturnFieldsPlus(readableAttributesForOrderedranges).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read Orderedranges, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetOrderedrangesResponseObjectAsResult.GetResult()
+	existing := res.GetRanges()
+
+	var missing, unknown []string
+	for _, rangeRef := range existing {
+		if !slices.Contains(ranges, rangeRef) {
+			missing = append(missing, rangeName(rangeRef))
+		}
+	}
+	for _, rangeRef := range ranges {
+		if !slices.Contains(existing, rangeRef) {
+			unknown = append(unknown, rangeName(rangeRef))
+		}
+	}
+
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("ranges"),
+			"Incomplete Range Order",
+			fmt.Sprintf("ranges must contain every range of network %s, missing: %s", res.GetNetwork(), strings.Join(missing, ", ")),
+		)
+	}
+	if len(unknown) > 0 {
+		diags.AddAttributeError(
+			path.Root("ranges"),
+			"Unknown Range",
+			fmt.Sprintf("The following ranges do not exist in network %s: %s", res.GetNetwork(), strings.Join(unknown, ", ")),
+		)
+	}
+}
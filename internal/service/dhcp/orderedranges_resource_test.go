@@ -0,0 +1,242 @@
+package dhcp_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForOrderedranges = "network,ranges"
+
+func TestAccOrderedrangesResource_basic(t *testing.T) {
+	var resourceName = "nios_dhcp_orderedranges.test"
+	var v dhcp.Orderedranges
+	networkPrefix := fmt.Sprintf("10.%d.%d", acctest.RandomNumber(255), acctest.RandomNumber(255))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccOrderedrangesBasicConfig(networkPrefix, "range1", "range2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedrangesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "network", "nios_ipam_network.test", "ref"),
+					resource.TestCheckResourceAttr(resourceName, "ranges.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "ranges.0", "nios_dhcp_range.range1", "ref"),
+					resource.TestCheckResourceAttrPair(resourceName, "ranges.1", "nios_dhcp_range.range2", "ref"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrderedrangesResource_Import(t *testing.T) {
+	var resourceName = "nios_dhcp_orderedranges.test"
+	var v dhcp.Orderedranges
+	networkPrefix := fmt.Sprintf("10.%d.%d", acctest.RandomNumber(255), acctest.RandomNumber(255))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderedrangesBasicConfig(networkPrefix, "range1", "range2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedrangesExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccOrderedrangesImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func TestAccOrderedrangesResource_Ranges(t *testing.T) {
+	var resourceName = "nios_dhcp_orderedranges.test"
+	var v dhcp.Orderedranges
+	networkPrefix := fmt.Sprintf("10.%d.%d", acctest.RandomNumber(255), acctest.RandomNumber(255))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccOrderedrangesBasicConfig(networkPrefix, "range1", "range2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedrangesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "ranges.0", "nios_dhcp_range.range1", "ref"),
+					resource.TestCheckResourceAttrPair(resourceName, "ranges.1", "nios_dhcp_range.range2", "ref"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccOrderedrangesBasicConfig(networkPrefix, "range2", "range1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedrangesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "ranges.0", "nios_dhcp_range.range2", "ref"),
+					resource.TestCheckResourceAttrPair(resourceName, "ranges.1", "nios_dhcp_range.range1", "ref"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrderedrangesResource_Drift(t *testing.T) {
+	var resourceName = "nios_dhcp_orderedranges.test"
+	var v dhcp.Orderedranges
+	networkPrefix := fmt.Sprintf("10.%d.%d", acctest.RandomNumber(255), acctest.RandomNumber(255))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Reorder the ranges outside of Terraform
+			{
+				Config: testAccOrderedrangesBasicConfig(networkPrefix, "range1", "range2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedrangesExists(context.Background(), resourceName, &v),
+					testAccCheckOrderedrangesReverse(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// The configured order is restored
+			{
+				Config: testAccOrderedrangesBasicConfig(networkPrefix, "range1", "range2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedrangesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "ranges.0", "nios_dhcp_range.range1", "ref"),
+					resource.TestCheckResourceAttrPair(resourceName, "ranges.1", "nios_dhcp_range.range2", "ref"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrderedrangesResource_MissingRange(t *testing.T) {
+	networkPrefix := fmt.Sprintf("10.%d.%d", acctest.RandomNumber(255), acctest.RandomNumber(255))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrderedrangesMissingRange(networkPrefix),
+				ExpectError: regexp.MustCompile("ranges must contain every range of network"),
+			},
+		},
+	})
+}
+
+func testAccCheckOrderedrangesExists(ctx context.Context, resourceName string, v *dhcp.Orderedranges) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DHCPAPI.
+			OrderedrangesAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForOrderedranges).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetOrderedrangesResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetOrderedrangesResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckOrderedrangesReverse(ctx context.Context, v *dhcp.Orderedranges) resource.TestCheckFunc {
+	// Reverse the order of the ranges externally to verify drift detection
+	return func(state *terraform.State) error {
+		ranges := make([]string, 0, len(v.Ranges))
+		for i := len(v.Ranges) - 1; i >= 0; i-- {
+			ranges = append(ranges, v.Ranges[i])
+		}
+		_, _, err := acctest.NIOSClient.DHCPAPI.
+			OrderedrangesAPI.
+			Update(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Orderedranges(dhcp.Orderedranges{Ranges: ranges}).
+			Execute()
+		return err
+	}
+}
+
+func testAccOrderedrangesImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccOrderedrangesRanges(networkPrefix string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_network" "test" {
+  network      = "%[1]s.0/24"
+  network_view = "default"
+}
+
+resource "nios_dhcp_range" "range1" {
+  start_addr = "%[1]s.10"
+  end_addr   = "%[1]s.20"
+  depends_on = [nios_ipam_network.test]
+}
+
+resource "nios_dhcp_range" "range2" {
+  start_addr = "%[1]s.30"
+  end_addr   = "%[1]s.40"
+  depends_on = [nios_ipam_network.test]
+}
+`, networkPrefix)
+}
+
+func testAccOrderedrangesBasicConfig(networkPrefix, first, second string) string {
+	config := fmt.Sprintf(`
+resource "nios_dhcp_orderedranges" "test" {
+  network = nios_ipam_network.test.ref
+  ranges  = [nios_dhcp_range.%s.ref, nios_dhcp_range.%s.ref]
+}
+`, first, second)
+	return strings.Join([]string{testAccOrderedrangesRanges(networkPrefix), config}, "")
+}
+
+func testAccOrderedrangesMissingRange(networkPrefix string) string {
+	config := `
+resource "nios_dhcp_orderedranges" "test" {
+  network = nios_ipam_network.test.ref
+  ranges  = [nios_dhcp_range.range1.ref]
+
+  depends_on = [nios_dhcp_range.range2]
+}
+`
+	return strings.Join([]string{testAccOrderedrangesRanges(networkPrefix), config}, "")
+}
@@ -0,0 +1,190 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CopyzonerecordsResource{}
+var _ resource.ResourceWithValidateConfig = &CopyzonerecordsResource{}
+
+func NewCopyzonerecordsResource() resource.Resource {
+	return &CopyzonerecordsResource{}
+}
+
+// CopyzonerecordsResource defines the resource implementation.
+type CopyzonerecordsResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *CopyzonerecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_copyzonerecords"
+}
+
+func (r *CopyzonerecordsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Copies the records of an authoritative zone to another authoritative zone, e.g. to set up the internal view of a split-horizon DNS. " +
+			"The records are copied with their TTLs when the resource is created and again whenever an argument other than the zones changes. " +
+			"Changes to the records of the source zone are not detected, use `triggers` to copy the records again after they changed. " +
+			"Destroying the resource only removes it from the Terraform state, the copied records are kept.",
+		Attributes: CopyzonerecordsResourceSchemaAttributes,
+	}
+}
+
+func (r *CopyzonerecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CopyzonerecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CopyzonerecordsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.copyRecords(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CopyzonerecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CopyzonerecordsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The copy is not an object in NIOS, so only the zones are checked. If either zone was deleted,
+	// the resource is removed from the state and the records are copied again once the zones are back.
+	for _, zoneRef := range []string{data.SourceZone.ValueString(), data.DestinationZone.ValueString()} {
+		_, httpRes, err := r.client.DNSAPI.
+			ZoneAuthAPI.
+			Read(ctx, utils.ExtractResourceRef(zoneRef)).
+			ReturnFields("fqdn").
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ZoneAuth %s, got error: %s", zoneRef, err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CopyzonerecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CopyzonerecordsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.copyRecords(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CopyzonerecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The copied records are not owned by this resource, so just clear state
+	tflog.Info(ctx, "Removing the copy of zone records from state, the copied records are kept")
+}
+
+func (r *CopyzonerecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CopyzonerecordsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SourceZone.IsUnknown() && !data.DestinationZone.IsUnknown() && data.SourceZone.ValueString() == data.DestinationZone.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destination_zone"),
+			"Invalid Configuration",
+			"destination_zone must be different from source_zone.",
+		)
+	}
+}
+
+// copyRecords copies the records of the source zone to the destination zone. The client does not model
+// the copyzonerecords function, so it is called through the WAPI directly.
+func (r *CopyzonerecordsResource) copyRecords(ctx context.Context, data *CopyzonerecordsModel, diags *diag.Diagnostics) {
+	baseUrl := r.client.DNSAPI.Cfg.NIOSHostURL
+	username := r.client.DNSAPI.Cfg.NIOSUsername
+	password := r.client.DNSAPI.Cfg.NIOSPassword
+
+	args := map[string]any{
+		"destination_zone":         data.DestinationZone.ValueString(),
+		"clear_destination_first":  data.ClearDestinationFirst.ValueBool(),
+		"replace_existing_records": data.ReplaceExistingRecords.ValueBool(),
+	}
+	if !data.SelectRecords.IsNull() && !data.SelectRecords.IsUnknown() {
+		args["select_records"] = flex.ExpandFrameworkListString(ctx, data.SelectRecords, diags)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Copying zone records", map[string]any{
+		"source_zone":      data.SourceZone.ValueString(),
+		"destination_zone": data.DestinationZone.ValueString(),
+	})
+	_, err := utils.CallWAPIFunction(ctx, baseUrl, username, password, data.SourceZone.ValueString(), "copyzonerecords", args)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to copy records of ZoneAuth %s, got error: %s", data.SourceZone.ValueString(), err))
+	}
+}
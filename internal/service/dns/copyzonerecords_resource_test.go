@@ -0,0 +1,300 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccCopyzonerecordsResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_copyzonerecords.test"
+	view := acctest.RandomNameWithPrefix("view")
+	zoneFqdn := acctest.RandomNameWithPrefix("copy-zone") + ".com"
+	recordName := "host." + zoneFqdn
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCopyzonerecordsBasicConfig(view, zoneFqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "source_zone", "nios_dns_zone_auth.source", "ref"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_zone", "nios_dns_zone_auth.destination", "ref"),
+					resource.TestCheckResourceAttr(resourceName, "replace_existing_records", "false"),
+					resource.TestCheckResourceAttr(resourceName, "clear_destination_first", "false"),
+					testAccCheckCopyzonerecordsRecordACopied(context.Background(), recordName, view),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCopyzonerecordsResource_SelectRecords(t *testing.T) {
+	var resourceName = "nios_dns_copyzonerecords.test"
+	view := acctest.RandomNameWithPrefix("view")
+	zoneFqdn := acctest.RandomNameWithPrefix("copy-zone") + ".com"
+	recordName := "host." + zoneFqdn
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCopyzonerecordsSelectRecords(view, zoneFqdn, "A"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "select_records.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "select_records.0", "A"),
+					testAccCheckCopyzonerecordsRecordACopied(context.Background(), recordName, view),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccCopyzonerecordsSelectRecords(view, zoneFqdn, "CNAME"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "select_records.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "select_records.0", "CNAME"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCopyzonerecordsResource_ReplaceExistingRecords(t *testing.T) {
+	var resourceName = "nios_dns_copyzonerecords.test"
+	view := acctest.RandomNameWithPrefix("view")
+	zoneFqdn := acctest.RandomNameWithPrefix("copy-zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCopyzonerecordsReplaceExistingRecords(view, zoneFqdn, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "replace_existing_records", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccCopyzonerecordsReplaceExistingRecords(view, zoneFqdn, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "replace_existing_records", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCopyzonerecordsResource_Triggers(t *testing.T) {
+	var resourceName = "nios_dns_copyzonerecords.test"
+	view := acctest.RandomNameWithPrefix("view")
+	zoneFqdn := acctest.RandomNameWithPrefix("copy-zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCopyzonerecordsTriggers(view, zoneFqdn, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccCopyzonerecordsTriggers(view, zoneFqdn, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCopyzonerecordsResource_CopyMissingRecords(t *testing.T) {
+	view := acctest.RandomNameWithPrefix("view")
+	zoneFqdn := acctest.RandomNameWithPrefix("copy-zone") + ".com"
+	recordName := "host." + zoneFqdn
+	newRecordName := "host2." + zoneFqdn
+	var recordRef string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCopyzonerecordsTriggers(view, zoneFqdn, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCopyzonerecordsRecordACopiedOnce(context.Background(), recordName, view, &recordRef),
+				),
+			},
+			// Add a record to the source zone and copy again, only the missing record is added
+			{
+				Config: testAccCopyzonerecordsNewRecord(view, zoneFqdn, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCopyzonerecordsRecordACopiedOnce(context.Background(), recordName, view, &recordRef),
+					testAccCheckCopyzonerecordsRecordACopied(context.Background(), newRecordName, view),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckCopyzonerecordsRecordACopiedOnce verifies that the record was copied exactly once. The reference of the
+// copied record is stored in ref, or compared with it if already set, to verify that the record was not replaced.
+func testAccCheckCopyzonerecordsRecordACopiedOnce(ctx context.Context, name, view string, ref *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordAAPI.
+			List(ctx).
+			Filters(map[string]interface{}{
+				"name": name,
+				"view": view,
+			}).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		records := apiRes.ListRecordAResponseObject.GetResult()
+		if len(records) != 1 {
+			return fmt.Errorf("expected record %s to be copied once to view %s, found %d records", name, view, len(records))
+		}
+		if *ref == "" {
+			*ref = records[0].GetRef()
+		} else if records[0].GetRef() != *ref {
+			return fmt.Errorf("expected record %s in view %s to be kept, but it was replaced", name, view)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCopyzonerecordsRecordACopied(ctx context.Context, name, view string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordAAPI.
+			List(ctx).
+			Filters(map[string]interface{}{
+				"name": name,
+				"view": view,
+			}).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if len(apiRes.ListRecordAResponseObject.GetResult()) == 0 {
+			return fmt.Errorf("expected record %s to be copied to view %s", name, view)
+		}
+		return nil
+	}
+}
+
+func testAccCopyzonerecordsBaseConfig(view, zoneFqdn string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "destination" {
+  name = %[1]q
+}
+
+resource "nios_dns_zone_auth" "source" {
+  fqdn = %[2]q
+  view = "default"
+}
+
+resource "nios_dns_zone_auth" "destination" {
+  fqdn = %[2]q
+  view = nios_dns_view.destination.name
+}
+
+resource "nios_dns_record_a" "source" {
+  name     = "host.${nios_dns_zone_auth.source.fqdn}"
+  ipv4addr = "10.0.0.20"
+  view     = "default"
+}
+`, view, zoneFqdn)
+}
+
+func testAccCopyzonerecordsBasicConfig(view, zoneFqdn string) string {
+	config := `
+resource "nios_dns_copyzonerecords" "test" {
+  source_zone      = nios_dns_zone_auth.source.ref
+  destination_zone = nios_dns_zone_auth.destination.ref
+  depends_on       = [nios_dns_record_a.source]
+}
+`
+	return testAccCopyzonerecordsBaseConfig(view, zoneFqdn) + config
+}
+
+func testAccCopyzonerecordsSelectRecords(view, zoneFqdn, recordType string) string {
+	config := fmt.Sprintf(`
+resource "nios_dns_copyzonerecords" "test" {
+  source_zone      = nios_dns_zone_auth.source.ref
+  destination_zone = nios_dns_zone_auth.destination.ref
+  select_records   = [%q]
+  depends_on       = [nios_dns_record_a.source]
+}
+`, recordType)
+	return testAccCopyzonerecordsBaseConfig(view, zoneFqdn) + config
+}
+
+func testAccCopyzonerecordsReplaceExistingRecords(view, zoneFqdn, replaceExistingRecords string) string {
+	config := fmt.Sprintf(`
+resource "nios_dns_copyzonerecords" "test" {
+  source_zone              = nios_dns_zone_auth.source.ref
+  destination_zone         = nios_dns_zone_auth.destination.ref
+  replace_existing_records = %s
+  depends_on               = [nios_dns_record_a.source]
+}
+`, replaceExistingRecords)
+	return testAccCopyzonerecordsBaseConfig(view, zoneFqdn) + config
+}
+
+func testAccCopyzonerecordsTriggers(view, zoneFqdn, run string) string {
+	config := fmt.Sprintf(`
+resource "nios_dns_copyzonerecords" "test" {
+  source_zone      = nios_dns_zone_auth.source.ref
+  destination_zone = nios_dns_zone_auth.destination.ref
+  triggers = {
+    run = %q
+  }
+  depends_on = [nios_dns_record_a.source]
+}
+`, run)
+	return testAccCopyzonerecordsBaseConfig(view, zoneFqdn) + config
+}
+
+func testAccCopyzonerecordsNewRecord(view, zoneFqdn, run string) string {
+	config := fmt.Sprintf(`
+resource "nios_dns_record_a" "source_new" {
+  name     = "host2.${nios_dns_zone_auth.source.fqdn}"
+  ipv4addr = "10.0.0.21"
+  view     = "default"
+}
+
+resource "nios_dns_copyzonerecords" "test" {
+  source_zone      = nios_dns_zone_auth.source.ref
+  destination_zone = nios_dns_zone_auth.destination.ref
+  triggers = {
+    run = %q
+  }
+  depends_on = [nios_dns_record_a.source, nios_dns_record_a.source_new]
+}
+`, run)
+	return testAccCopyzonerecordsBaseConfig(view, zoneFqdn) + config
+}
@@ -0,0 +1,290 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDdnsPrincipalclusterGroup = "clusters,comment,name"
+
+func TestAccDdnsPrincipalclusterGroupResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster_group.test"
+	var v dns.DdnsPrincipalclusterGroup
+	name := acctest.RandomNameWithPrefix("ddns-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterGroupResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_ddns_principalcluster_group.test"
+	var v dns.DdnsPrincipalclusterGroup
+	name := acctest.RandomNameWithPrefix("ddns-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDdnsPrincipalclusterGroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdnsPrincipalclusterGroupBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					testAccCheckDdnsPrincipalclusterGroupDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterGroupResource_Clusters(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster_group.test_clusters"
+	var v dns.DdnsPrincipalclusterGroup
+	name := acctest.RandomNameWithPrefix("ddns-group")
+	clusterName := acctest.RandomNameWithPrefix("ddns-cluster")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the group and a cluster in it
+			{
+				Config: testAccDdnsPrincipalclusterGroupClusters(name, clusterName),
+			},
+			// The membership of the cluster is read back after a refresh
+			{
+				Config: testAccDdnsPrincipalclusterGroupClusters(name, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "clusters.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterGroupResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster_group.test_comment"
+	var v dns.DdnsPrincipalclusterGroup
+	name := acctest.RandomNameWithPrefix("ddns-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupComment(name, "This is a test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a test comment"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupComment(name, "This is an updated test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated test comment"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterGroupResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster_group.test_name"
+	var v dns.DdnsPrincipalclusterGroup
+	name := acctest.RandomNameWithPrefix("ddns-group")
+	nameUpdate := acctest.RandomNameWithPrefix("ddns-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupName(nameUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", nameUpdate),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterGroupResource_ZoneReference(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster_group.test_zone_reference"
+	var zoneResourceName = "nios_dns_zone_auth.test_zone_reference"
+	var v dns.DdnsPrincipalclusterGroup
+	var zone dns.ZoneAuth
+	name := acctest.RandomNameWithPrefix("ddns-group")
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupZoneReference(name, zoneFqdn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					testAccCheckZoneAuthExists(context.Background(), zoneResourceName, &zone),
+					resource.TestCheckResourceAttrPair(zoneResourceName, "ddns_principal_group", resourceName, "name"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckDdnsPrincipalclusterGroupExists(ctx context.Context, resourceName string, v *dns.DdnsPrincipalclusterGroup) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterGroupAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForDdnsPrincipalclusterGroup).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetDdnsPrincipalclusterGroupResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetDdnsPrincipalclusterGroupResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckDdnsPrincipalclusterGroupDestroy(ctx context.Context, v *dns.DdnsPrincipalclusterGroup) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterGroupAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForDdnsPrincipalclusterGroup).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckDdnsPrincipalclusterGroupDisappears(ctx context.Context, v *dns.DdnsPrincipalclusterGroup) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterGroupAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccDdnsPrincipalclusterGroupBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test" {
+    name = %q
+}
+`, name)
+}
+
+func testAccDdnsPrincipalclusterGroupClusters(name, clusterName string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test_clusters" {
+    name = %q
+}
+
+resource "nios_dns_ddns_principalcluster" "test_clusters" {
+    name = %q
+    principals = ["DHCP/dhcp1.example.com@EXAMPLE.COM"]
+    group = nios_dns_ddns_principalcluster_group.test_clusters.name
+}
+`, name, clusterName)
+}
+
+func testAccDdnsPrincipalclusterGroupComment(name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test_comment" {
+    name = %q
+    comment = %q
+}
+`, name, comment)
+}
+
+func testAccDdnsPrincipalclusterGroupName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test_name" {
+    name = %q
+}
+`, name)
+}
+
+func testAccDdnsPrincipalclusterGroupZoneReference(name, zoneFqdn string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test_zone_reference" {
+    name = %q
+}
+
+resource "nios_dns_zone_auth" "test_zone_reference" {
+    fqdn = %q
+    view = "default"
+    ddns_principal_group = nios_dns_ddns_principalcluster_group.test_zone_reference.name
+    use_ddns_principal_security = true
+}
+`, name, zoneFqdn)
+}
@@ -0,0 +1,317 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDdnsPrincipalcluster = "comment,group,name,principals"
+
+func TestAccDdnsPrincipalclusterResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster.test"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-cluster")
+	principals := []string{"DHCP/dhcp1.example.com@EXAMPLE.COM"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterBasicConfig(name, principals),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "principals.0", principals[0]),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "group"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_ddns_principalcluster.test"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-cluster")
+	principals := []string{"DHCP/dhcp1.example.com@EXAMPLE.COM"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDdnsPrincipalclusterDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdnsPrincipalclusterBasicConfig(name, principals),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					testAccCheckDdnsPrincipalclusterDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster.test_comment"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-cluster")
+	principals := []string{"DHCP/dhcp1.example.com@EXAMPLE.COM"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterComment(name, principals, "This is a test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a test comment"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterComment(name, principals, "This is an updated test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated test comment"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterResource_Group(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster.test_group"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-cluster")
+	groupName := acctest.RandomNameWithPrefix("ddns-group")
+	groupNameUpdate := acctest.RandomNameWithPrefix("ddns-group")
+	principals := []string{"DHCP/dhcp1.example.com@EXAMPLE.COM"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroup(name, principals, groupName, groupNameUpdate, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "group", groupName),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroup(name, principals, groupName, groupNameUpdate, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "group", groupNameUpdate),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster.test_name"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-cluster")
+	nameUpdate := acctest.RandomNameWithPrefix("ddns-cluster")
+	principals := []string{"DHCP/dhcp1.example.com@EXAMPLE.COM"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterName(name, principals),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterName(nameUpdate, principals),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", nameUpdate),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterResource_Principals(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster.test_principals"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-cluster")
+	principals := []string{"DHCP/dhcp1.example.com@EXAMPLE.COM"}
+	principalsUpdate := []string{"DHCP/dhcp1.example.com@EXAMPLE.COM", "DHCP/dhcp2.example.com@EXAMPLE.COM"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterPrincipals(name, principals),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "principals.0", principals[0]),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterPrincipals(name, principalsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "principals.1", principalsUpdate[1]),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckDdnsPrincipalclusterExists(ctx context.Context, resourceName string, v *dns.DdnsPrincipalcluster) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForDdnsPrincipalcluster).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetDdnsPrincipalclusterResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetDdnsPrincipalclusterResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckDdnsPrincipalclusterDestroy(ctx context.Context, v *dns.DdnsPrincipalcluster) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForDdnsPrincipalcluster).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckDdnsPrincipalclusterDisappears(ctx context.Context, v *dns.DdnsPrincipalcluster) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccDdnsPrincipalclusterBasicConfig(name string, principals []string) string {
+	principalsStr := utils.ConvertStringSliceToHCL(principals)
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster" "test" {
+    name = %q
+    principals = %s
+}
+`, name, principalsStr)
+}
+
+func testAccDdnsPrincipalclusterComment(name string, principals []string, comment string) string {
+	principalsStr := utils.ConvertStringSliceToHCL(principals)
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster" "test_comment" {
+    name = %q
+    principals = %s
+    comment = %q
+}
+`, name, principalsStr, comment)
+}
+
+func testAccDdnsPrincipalclusterGroup(name string, principals []string, groupName, groupNameUpdate, group string) string {
+	principalsStr := utils.ConvertStringSliceToHCL(principals)
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test1" {
+    name = %q
+}
+
+resource "nios_dns_ddns_principalcluster_group" "test2" {
+    name = %q
+}
+
+resource "nios_dns_ddns_principalcluster" "test_group" {
+    name = %q
+    principals = %s
+    group = nios_dns_ddns_principalcluster_group.%s.name
+}
+`, groupName, groupNameUpdate, name, principalsStr, group)
+}
+
+func testAccDdnsPrincipalclusterName(name string, principals []string) string {
+	principalsStr := utils.ConvertStringSliceToHCL(principals)
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster" "test_name" {
+    name = %q
+    principals = %s
+}
+`, name, principalsStr)
+}
+
+func testAccDdnsPrincipalclusterPrincipals(name string, principals []string) string {
+	principalsStr := utils.ConvertStringSliceToHCL(principals)
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster" "test_principals" {
+    name = %q
+    principals = %s
+}
+`, name, principalsStr)
+}
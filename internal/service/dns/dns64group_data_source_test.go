@@ -0,0 +1,107 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDns64groupDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_dns64group.test"
+	resourceName := "nios_dns_dns64group.test"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDns64groupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDns64groupDataSourceConfigFilters(name),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					}, testAccCheckDns64groupResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccDns64groupDataSource_ExtAttrFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_dns64group.test"
+	resourceName := "nios_dns_dns64group.test"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64-group")
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDns64groupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDns64groupDataSourceConfigExtAttrFilters(name, extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					}, testAccCheckDns64groupResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckDns64groupResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "clients", dataSourceName, "result.0.clients"),
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "enable_dnssec_dns64", dataSourceName, "result.0.enable_dnssec_dns64"),
+		resource.TestCheckResourceAttrPair(resourceName, "exclude", dataSourceName, "result.0.exclude"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "mapped", dataSourceName, "result.0.mapped"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "prefix", dataSourceName, "result.0.prefix"),
+	}
+}
+
+func testAccDns64groupDataSourceConfigFilters(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test" {
+  name = %q
+}
+
+data "nios_dns_dns64group" "test" {
+  filters = {
+    name = nios_dns_dns64group.test.name
+  }
+}
+`, name)
+}
+
+func testAccDns64groupDataSourceConfigExtAttrFilters(name, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test" {
+  name = %q
+  extattrs = {
+    Site = %q
+  }
+}
+
+data "nios_dns_dns64group" "test" {
+  extattrfilters = {
+    Site = nios_dns_dns64group.test.extattrs.Site
+  }
+}
+`, name, extAttrsValue)
+}
@@ -0,0 +1,213 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HostnamePolicyCheckDataSource{}
+
+func NewHostnamePolicyCheckDataSource() datasource.DataSource {
+	return &HostnamePolicyCheckDataSource{}
+}
+
+// HostnamePolicyCheckDataSource defines the data source implementation.
+type HostnamePolicyCheckDataSource struct {
+	client *niosclient.APIClient
+}
+
+func (d *HostnamePolicyCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_hostname_policy_check"
+}
+
+func (d *HostnamePolicyCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	policyValidators := []validator.String{
+		stringvalidator.ExactlyOneOf(
+			path.MatchRoot("record_name_policy"),
+			path.MatchRoot("hostname_rewrite_policy"),
+		),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tests sample hostnames against a Record Name Policy or a Hostname Rewrite Policy. " +
+			"The policy is read from the Grid and evaluated by the provider: every label of a hostname must match the regular expression " +
+			"of a record name policy, while a hostname rewrite policy replaces the characters that are not valid.",
+		Attributes: map[string]schema.Attribute{
+			"record_name_policy": schema.StringAttribute{
+				Optional:            true,
+				Validators:          policyValidators,
+				MarkdownDescription: "The name of the Record Name Policy to test the hostnames against.",
+			},
+			"hostname_rewrite_policy": schema.StringAttribute{
+				Optional:            true,
+				Validators:          policyValidators,
+				MarkdownDescription: "The name of the Hostname Rewrite Policy to test the hostnames against.",
+			},
+			"hostnames": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				MarkdownDescription: "The hostnames to test.",
+			},
+			"compliant": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether all hostnames comply with the policy.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hostname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The tested hostname.",
+						},
+						"compliant": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the hostname complies with the policy.",
+						},
+						"rewritten_hostname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The hostname after the Hostname Rewrite Policy is applied. Not set for a Record Name Policy.",
+						},
+					},
+				},
+				MarkdownDescription: "The result of the test of each hostname, in the order of `hostnames`.",
+			},
+		},
+	}
+}
+
+func (d *HostnamePolicyCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HostnamePolicyCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HostnamePolicyCheckModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostnames := flex.ExpandFrameworkListString(ctx, data.Hostnames, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var results []HostnamePolicyCheckResultModel
+	if !data.RecordNamePolicy.IsNull() {
+		results = d.checkRecordNamePolicy(ctx, data.RecordNamePolicy.ValueString(), hostnames, &resp.Diagnostics)
+	} else {
+		results = d.checkHostnameRewritePolicy(ctx, data.HostnameRewritePolicy.ValueString(), hostnames, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compliant := true
+	for _, result := range results {
+		compliant = compliant && result.Compliant.ValueBool()
+	}
+	data.Compliant = types.BoolValue(compliant)
+
+	resultsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HostnamePolicyCheckResultAttrTypes}, results)
+	resp.Diagnostics.Append(diags...)
+	data.Results = resultsList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// checkRecordNamePolicy tests the hostnames against the regular expression of the record name policy.
+func (d *HostnamePolicyCheckDataSource) checkRecordNamePolicy(ctx context.Context, name string, hostnames []string, diags *diag.Diagnostics) []HostnamePolicyCheckResultModel {
+	apiRes, _, err := d.client.DNSAPI.
+		RecordnamepolicyAPI.
+		List(ctx).
+		Filters(map[string]any{"name": name}).
+		ReturnFieldsPlus(readableAttributesForRecordnamepolicy).
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read Recordnamepolicy %s, got error: %s", name, err))
+		return nil
+	}
+	list := apiRes.ListRecordnamepolicyResponseObject.GetResult()
+	if len(list) == 0 {
+		diags.AddError("Not Found", fmt.Sprintf("No Recordnamepolicy named %s exists", name))
+		return nil
+	}
+
+	results := make([]HostnamePolicyCheckResultModel, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		result, err := checkRecordName(list[0].GetRegex(), hostname)
+		if err != nil {
+			diags.AddError("Unsupported Record Name Policy", err.Error())
+			return nil
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// checkHostnameRewritePolicy rewrites the hostnames with the valid and replacement characters of the hostname rewrite policy.
+func (d *HostnamePolicyCheckDataSource) checkHostnameRewritePolicy(ctx context.Context, name string, hostnames []string, diags *diag.Diagnostics) []HostnamePolicyCheckResultModel {
+	apiRes, _, err := d.client.IPAMAPI.
+		HostnamerewritepolicyAPI.
+		List(ctx).
+		Filters(map[string]any{"name": name}).
+		ReturnFields("name,replacement_character,valid_characters").
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read Hostnamerewritepolicy %s, got error: %s", name, err))
+		return nil
+	}
+	list := apiRes.ListHostnamerewritepolicyResponseObject.GetResult()
+	if len(list) == 0 {
+		diags.AddError("Not Found", fmt.Sprintf("No Hostnamerewritepolicy named %s exists", name))
+		return nil
+	}
+
+	results := make([]HostnamePolicyCheckResultModel, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		results = append(results, rewriteHostname(list[0].GetValidCharacters(), list[0].GetReplacementCharacter(), hostname))
+	}
+	return results
+}
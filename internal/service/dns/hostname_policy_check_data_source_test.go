@@ -0,0 +1,91 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO: OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// The predefined Hostname Rewrite Policy - Default
+
+func TestAccHostnamePolicyCheckDataSource_RecordNamePolicy(t *testing.T) {
+	dataSourceName := "data.nios_dns_hostname_policy_check.test"
+	name := acctest.RandomNameWithPrefix("record-name-policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostnamePolicyCheckDataSourceConfigRecordNamePolicy(name, []string{"host-1.example.com", "host_2.example.com"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compliant", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.hostname", "host-1.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.compliant", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.hostname", "host_2.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.compliant", "false"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "results.0.rewritten_hostname"),
+				),
+			},
+			{
+				Config: testAccHostnamePolicyCheckDataSourceConfigRecordNamePolicy(name, []string{"host-1.example.com"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compliant", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHostnamePolicyCheckDataSource_HostnameRewritePolicy(t *testing.T) {
+	dataSourceName := "data.nios_dns_hostname_policy_check.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostnamePolicyCheckDataSourceConfigHostnameRewritePolicy("Default", []string{"host-1", "host 2"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compliant", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.compliant", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.rewritten_hostname", "host-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.compliant", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.1.rewritten_hostname"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccHostnamePolicyCheckDataSourceConfigRecordNamePolicy(name string, hostnames []string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_recordnamepolicy" "test" {
+  name = %q
+  regex = "^[a-z0-9-]+$"
+}
+
+data "nios_dns_hostname_policy_check" "test" {
+  record_name_policy = nios_dns_recordnamepolicy.test.name
+  hostnames = %s
+}
+`, name, utils.ConvertStringSliceToHCL(hostnames))
+}
+
+func testAccHostnamePolicyCheckDataSourceConfigHostnameRewritePolicy(name string, hostnames []string) string {
+	return fmt.Sprintf(`
+data "nios_dns_hostname_policy_check" "test" {
+  hostname_rewrite_policy = %q
+  hostnames = %s
+}
+`, name, utils.ConvertStringSliceToHCL(hostnames))
+}
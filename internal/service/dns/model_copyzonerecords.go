@@ -0,0 +1,86 @@
+package dns
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type CopyzonerecordsModel struct {
+	SourceZone             types.String `tfsdk:"source_zone"`
+	DestinationZone        types.String `tfsdk:"destination_zone"`
+	SelectRecords          types.List   `tfsdk:"select_records"`
+	ReplaceExistingRecords types.Bool   `tfsdk:"replace_existing_records"`
+	ClearDestinationFirst  types.Bool   `tfsdk:"clear_destination_first"`
+	Triggers               types.Map    `tfsdk:"triggers"`
+}
+
+var CopyzonerecordsAttrTypes = map[string]attr.Type{
+	"source_zone":              types.StringType,
+	"destination_zone":         types.StringType,
+	"select_records":           types.ListType{ElemType: types.StringType},
+	"replace_existing_records": types.BoolType,
+	"clear_destination_first":  types.BoolType,
+	"triggers":                 types.MapType{ElemType: types.StringType},
+}
+
+var CopyzonerecordsResourceSchemaAttributes = map[string]schema.Attribute{
+	"source_zone": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The reference to the authoritative zone to copy the records from.",
+	},
+	"destination_zone": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The reference to the authoritative zone to copy the records to. The zone can be in another DNS view.",
+	},
+	"select_records": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(customvalidator.ValidateTrimmedString()),
+		},
+		MarkdownDescription: "The types of the records to copy, e.g. `A`, `AAAA`, `CNAME`, `HOST`, `MX`, `PTR`, `SRV` or `TXT`. If not set, records of all types are copied.",
+	},
+	"replace_existing_records": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Replaces the records that already exist in the destination zone with the records of the source zone. " +
+			"If false, the records that already exist in the destination zone are kept and only the missing records are copied.",
+	},
+	"clear_destination_first": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Deletes all records of the destination zone before the records are copied.",
+	},
+	"triggers": schema.MapAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		MarkdownDescription: "Arbitrary values that copy the records again when they change, e.g. a value that changes whenever records are added to the source zone.",
+	},
+}
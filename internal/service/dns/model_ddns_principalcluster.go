@@ -0,0 +1,115 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type DdnsPrincipalclusterModel struct {
+	Ref        types.String `tfsdk:"ref"`
+	Comment    types.String `tfsdk:"comment"`
+	Group      types.String `tfsdk:"group"`
+	Name       types.String `tfsdk:"name"`
+	Principals types.List   `tfsdk:"principals"`
+}
+
+var DdnsPrincipalclusterAttrTypes = map[string]attr.Type{
+	"ref":        types.StringType,
+	"comment":    types.StringType,
+	"group":      types.StringType,
+	"name":       types.StringType,
+	"principals": types.ListType{ElemType: types.StringType},
+}
+
+var DdnsPrincipalclusterResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "Comment for the DDNS Principal Cluster.",
+	},
+	"group": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the DDNS Principal Cluster Group the cluster belongs to. If not set, the cluster is added to the default group.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of this DDNS Principal Cluster.",
+	},
+	"principals": schema.ListAttribute{
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(customvalidator.ValidateTrimmedString()),
+		},
+		MarkdownDescription: "The list of equivalent principals, e.g. the Kerberos principals of the DHCP servers that may update the same records.",
+	},
+}
+
+func (m *DdnsPrincipalclusterModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.DdnsPrincipalcluster {
+	if m == nil {
+		return nil
+	}
+	to := &dns.DdnsPrincipalcluster{
+		Comment:    flex.ExpandStringPointer(m.Comment),
+		Group:      flex.ExpandStringPointer(m.Group),
+		Name:       flex.ExpandStringPointer(m.Name),
+		Principals: flex.ExpandFrameworkListString(ctx, m.Principals, diags),
+	}
+	return to
+}
+
+func FlattenDdnsPrincipalcluster(ctx context.Context, from *dns.DdnsPrincipalcluster, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DdnsPrincipalclusterAttrTypes)
+	}
+	m := DdnsPrincipalclusterModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, DdnsPrincipalclusterAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *DdnsPrincipalclusterModel) Flatten(ctx context.Context, from *dns.DdnsPrincipalcluster, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = DdnsPrincipalclusterModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Group = flex.FlattenStringPointer(from.Group)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Principals = flex.FlattenFrameworkListString(ctx, from.Principals, diags)
+}
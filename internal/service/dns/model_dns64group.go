@@ -0,0 +1,195 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	importmod "github.com/infobloxopen/terraform-provider-nios/internal/planmodifiers/import"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type Dns64groupModel struct {
+	Ref               types.String `tfsdk:"ref"`
+	Clients           types.List   `tfsdk:"clients"`
+	Comment           types.String `tfsdk:"comment"`
+	Disable           types.Bool   `tfsdk:"disable"`
+	EnableDnssecDns64 types.Bool   `tfsdk:"enable_dnssec_dns64"`
+	Exclude           types.List   `tfsdk:"exclude"`
+	ExtAttrs          types.Map    `tfsdk:"extattrs"`
+	ExtAttrsAll       types.Map    `tfsdk:"extattrs_all"`
+	Mapped            types.List   `tfsdk:"mapped"`
+	Name              types.String `tfsdk:"name"`
+	Prefix            types.String `tfsdk:"prefix"`
+}
+
+var Dns64groupAttrTypes = map[string]attr.Type{
+	"ref":                 types.StringType,
+	"clients":             types.ListType{ElemType: types.ObjectType{AttrTypes: Dns64groupClientsAttrTypes}},
+	"comment":             types.StringType,
+	"disable":             types.BoolType,
+	"enable_dnssec_dns64": types.BoolType,
+	"exclude":             types.ListType{ElemType: types.ObjectType{AttrTypes: Dns64groupExcludeAttrTypes}},
+	"extattrs":            types.MapType{ElemType: types.StringType},
+	"extattrs_all":        types.MapType{ElemType: types.StringType},
+	"mapped":              types.ListType{ElemType: types.ObjectType{AttrTypes: Dns64groupMappedAttrTypes}},
+	"name":                types.StringType,
+	"prefix":              types.StringType,
+}
+
+var Dns64groupResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"clients": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: Dns64groupClientsResourceSchemaAttributes,
+		},
+		Optional: true,
+		Computed: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "Access Control settings that contain IPv4 and IPv6 DNS clients and networks to which the DNS server is allowed to send synthesized AAAA records with the specified IPv6 prefix.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The descriptive comment for the DNS64 synthesis group object.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines whether the DNS64 synthesis group is disabled.",
+	},
+	"enable_dnssec_dns64": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines whether the DNS64 synthesis of AAAA records is enabled for DNS64 synthesis groups that request DNSSEC data.",
+	},
+	"exclude": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: Dns64groupExcludeResourceSchemaAttributes,
+		},
+		Optional: true,
+		Computed: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "Access Control settings that contain IPv6 addresses or prefix ranges that cannot be used by IPv6-only hosts, such as IP addresses in the ::ffff:0:0/96 network. When DNS server retrieves an AAAA record that contains an IPv6 address that matches an excluded address, it does not return the AAAA record. Instead it synthesizes an AAAA record from the A record.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Extensible attributes associated with the object.",
+		ElementType:         types.StringType,
+		Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+		Validators: []validator.Map{
+			mapvalidator.SizeAtLeast(1),
+		},
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		MarkdownDescription: "Extensible attributes associated with the object , including default attributes.",
+		ElementType:         types.StringType,
+		PlanModifiers: []planmodifier.Map{
+			importmod.AssociateInternalId(),
+		},
+	},
+	"mapped": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: Dns64groupMappedResourceSchemaAttributes,
+		},
+		Optional: true,
+		Computed: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "Access Control settings that contain IPv4 addresses and networks for which the DNS server can synthesize AAAA records with the specified prefix.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the DNS64 synthesis group object. Views and zones reference the group by this name in `dns64_groups`.",
+	},
+	"prefix": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("64:ff9b::/96"),
+		Validators: []validator.String{
+			customvalidator.IsValidIPCIDR(),
+		},
+		MarkdownDescription: "The IPv6 prefix used for the synthesized AAAA records. The prefix length must be /32, /40, /48, /56, /64 or /96, and all bits beyond the specified length must be zero.",
+	},
+}
+
+func (m *Dns64groupModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.Dns64group {
+	if m == nil {
+		return nil
+	}
+	to := &dns.Dns64group{
+		Clients:           flex.ExpandFrameworkListNestedBlock(ctx, m.Clients, diags, ExpandDns64groupClients),
+		Comment:           flex.ExpandStringPointer(m.Comment),
+		Disable:           flex.ExpandBoolPointer(m.Disable),
+		EnableDnssecDns64: flex.ExpandBoolPointer(m.EnableDnssecDns64),
+		Exclude:           flex.ExpandFrameworkListNestedBlock(ctx, m.Exclude, diags, ExpandDns64groupExclude),
+		ExtAttrs:          ExpandExtAttrs(ctx, m.ExtAttrs, diags),
+		Mapped:            flex.ExpandFrameworkListNestedBlock(ctx, m.Mapped, diags, ExpandDns64groupMapped),
+		Name:              flex.ExpandStringPointer(m.Name),
+		Prefix:            flex.ExpandStringPointer(m.Prefix),
+	}
+	return to
+}
+
+func FlattenDns64group(ctx context.Context, from *dns.Dns64group, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(Dns64groupAttrTypes)
+	}
+	m := Dns64groupModel{}
+	m.Flatten(ctx, from, diags)
+	m.ExtAttrsAll = types.MapNull(types.StringType)
+	t, d := types.ObjectValueFrom(ctx, Dns64groupAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *Dns64groupModel) Flatten(ctx context.Context, from *dns.Dns64group, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = Dns64groupModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Clients = flex.FlattenFrameworkListNestedBlock(ctx, from.Clients, Dns64groupClientsAttrTypes, diags, FlattenDns64groupClients)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.EnableDnssecDns64 = types.BoolPointerValue(from.EnableDnssecDns64)
+	m.Exclude = flex.FlattenFrameworkListNestedBlock(ctx, from.Exclude, Dns64groupExcludeAttrTypes, diags, FlattenDns64groupExclude)
+	m.ExtAttrs = FlattenExtAttrs(ctx, m.ExtAttrs, from.ExtAttrs, diags)
+	m.Mapped = flex.FlattenFrameworkListNestedBlock(ctx, from.Mapped, Dns64groupMappedAttrTypes, diags, FlattenDns64groupMapped)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Prefix = flex.FlattenStringPointer(from.Prefix)
+}
@@ -0,0 +1,70 @@
+package dns
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type HostnamePolicyCheckModel struct {
+	RecordNamePolicy      types.String `tfsdk:"record_name_policy"`
+	HostnameRewritePolicy types.String `tfsdk:"hostname_rewrite_policy"`
+	Hostnames             types.List   `tfsdk:"hostnames"`
+	Compliant             types.Bool   `tfsdk:"compliant"`
+	Results               types.List   `tfsdk:"results"`
+}
+
+type HostnamePolicyCheckResultModel struct {
+	Hostname          types.String `tfsdk:"hostname"`
+	Compliant         types.Bool   `tfsdk:"compliant"`
+	RewrittenHostname types.String `tfsdk:"rewritten_hostname"`
+}
+
+var HostnamePolicyCheckResultAttrTypes = map[string]attr.Type{
+	"hostname":           types.StringType,
+	"compliant":          types.BoolType,
+	"rewritten_hostname": types.StringType,
+}
+
+// checkRecordName returns whether every label of the hostname matches the regular expression of a record name policy.
+func checkRecordName(regex, hostname string) (HostnamePolicyCheckResultModel, error) {
+	re, err := regexp.CompilePOSIX(regex)
+	if err != nil {
+		return HostnamePolicyCheckResultModel{}, fmt.Errorf("the regular expression %q of the policy is not supported: %w", regex, err)
+	}
+
+	compliant := true
+	for _, label := range strings.Split(strings.TrimSuffix(hostname, "."), ".") {
+		if !re.MatchString(label) {
+			compliant = false
+			break
+		}
+	}
+	return HostnamePolicyCheckResultModel{
+		Hostname:          types.StringValue(hostname),
+		Compliant:         types.BoolValue(compliant),
+		RewrittenHostname: types.StringNull(),
+	}, nil
+}
+
+// rewriteHostname replaces every character of the hostname that is not one of the valid characters of a hostname
+// rewrite policy with its replacement character.
+func rewriteHostname(validCharacters, replacementCharacter, hostname string) HostnamePolicyCheckResultModel {
+	var b strings.Builder
+	for _, c := range hostname {
+		if strings.ContainsRune(validCharacters, c) {
+			b.WriteRune(c)
+		} else {
+			b.WriteString(replacementCharacter)
+		}
+	}
+	rewritten := b.String()
+	return HostnamePolicyCheckResultModel{
+		Hostname:          types.StringValue(hostname),
+		Compliant:         types.BoolValue(rewritten == hostname),
+		RewrittenHostname: types.StringValue(rewritten),
+	}
+}
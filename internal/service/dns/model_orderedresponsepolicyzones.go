@@ -0,0 +1,115 @@
+package dns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type OrderedresponsepolicyzonesModel struct {
+	Ref     types.String `tfsdk:"ref"`
+	RpZones types.List   `tfsdk:"rp_zones"`
+	View    types.String `tfsdk:"view"`
+}
+
+var OrderedresponsepolicyzonesAttrTypes = map[string]attr.Type{
+	"ref":      types.StringType,
+	"rp_zones": types.ListType{ElemType: types.StringType},
+	"view":     types.StringType,
+}
+
+var OrderedresponsepolicyzonesResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"rp_zones": schema.ListAttribute{
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(customvalidator.ValidateTrimmedString()),
+		},
+		MarkdownDescription: "The names of all Response Policy Zones of the DNS view, in the order in which they are evaluated. The first zone has the highest priority.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the DNS view whose Response Policy Zones are ordered.",
+	},
+}
+
+func (m *OrderedresponsepolicyzonesModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.Orderedresponsepolicyzones {
+	if m == nil {
+		return nil
+	}
+	to := &dns.Orderedresponsepolicyzones{
+		RpZones: flex.ExpandFrameworkListString(ctx, m.RpZones, diags),
+	}
+	return to
+}
+
+func FlattenOrderedresponsepolicyzones(ctx context.Context, from *dns.Orderedresponsepolicyzones, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(OrderedresponsepolicyzonesAttrTypes)
+	}
+	m := OrderedresponsepolicyzonesModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, OrderedresponsepolicyzonesAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *OrderedresponsepolicyzonesModel) Flatten(ctx context.Context, from *dns.Orderedresponsepolicyzones, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = OrderedresponsepolicyzonesModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.RpZones = flex.FlattenFrameworkListString(ctx, rpZoneNames(from.RpZones), diags)
+	m.View = flex.FlattenStringPointer(from.View)
+}
+
+// rpZoneNames returns the names of the Response Policy Zones. Zones that are returned as references,
+// e.g. "zone_rp/ZG5zLnpvbmUkLl9kZWZhdWx0LmNvbS5leGFtcGxl:rpz.example.com/default", are converted to their names.
+func rpZoneNames(rpZones []string) []string {
+	names := make([]string, 0, len(rpZones))
+	for _, zone := range rpZones {
+		if strings.HasPrefix(zone, "zone_rp/") {
+			if _, rest, ok := strings.Cut(zone, ":"); ok {
+				zone = rest
+				if i := strings.LastIndex(zone, "/"); i >= 0 {
+					zone = zone[:i]
+				}
+			}
+		}
+		names = append(names, zone)
+	}
+	return names
+}
@@ -0,0 +1,113 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type RecordDhcidModel struct {
+	Ref          types.String `tfsdk:"ref"`
+	CreationTime types.Int64  `tfsdk:"creation_time"`
+	Creator      types.String `tfsdk:"creator"`
+	Dhcid        types.String `tfsdk:"dhcid"`
+	DnsName      types.String `tfsdk:"dns_name"`
+	Name         types.String `tfsdk:"name"`
+	Ttl          types.Int64  `tfsdk:"ttl"`
+	UseTtl       types.Bool   `tfsdk:"use_ttl"`
+	View         types.String `tfsdk:"view"`
+	Zone         types.String `tfsdk:"zone"`
+}
+
+var RecordDhcidAttrTypes = map[string]attr.Type{
+	"ref":           types.StringType,
+	"creation_time": types.Int64Type,
+	"creator":       types.StringType,
+	"dhcid":         types.StringType,
+	"dns_name":      types.StringType,
+	"name":          types.StringType,
+	"ttl":           types.Int64Type,
+	"use_ttl":       types.BoolType,
+	"view":          types.StringType,
+	"zone":          types.StringType,
+}
+
+var RecordDhcidResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"creation_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The creation time of the record.",
+	},
+	"creator": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record creator.",
+	},
+	"dhcid": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The Base64 encoded DHCP client information.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name for the DHCID record in punycode format.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DHCID record in FQDN format.",
+	},
+	"ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The Time To Live (TTL) value for the record. A 32-bit unsigned integer that represents the duration, in seconds, for which the record is valid (cached). Zero indicates that the record should not be cached.",
+	},
+	"use_ttl": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Use flag for: ttl",
+	},
+	"view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DNS view in which the record resides. Example: \"external\".",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in which the record resides. Example: \"zone.com\". If a view is not specified when searching by zone, the default view is used.",
+	},
+}
+
+func FlattenRecordDhcid(ctx context.Context, from *dns.RecordDhcid, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordDhcidAttrTypes)
+	}
+	m := RecordDhcidModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordDhcidAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordDhcidModel) Flatten(ctx context.Context, from *dns.RecordDhcid, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordDhcidModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.CreationTime = flex.FlattenInt64Pointer(from.CreationTime)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.Dhcid = flex.FlattenStringPointer(from.Dhcid)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Ttl = flex.FlattenInt64Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,156 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type RecordDsModel struct {
+	Ref          types.String `tfsdk:"ref"`
+	Algorithm    types.String `tfsdk:"algorithm"`
+	CloudInfo    types.Object `tfsdk:"cloud_info"`
+	Comment      types.String `tfsdk:"comment"`
+	CreationTime types.Int64  `tfsdk:"creation_time"`
+	Creator      types.String `tfsdk:"creator"`
+	Digest       types.String `tfsdk:"digest"`
+	DigestType   types.String `tfsdk:"digest_type"`
+	DnsName      types.String `tfsdk:"dns_name"`
+	KeyTag       types.Int64  `tfsdk:"key_tag"`
+	LastQueried  types.Int64  `tfsdk:"last_queried"`
+	Name         types.String `tfsdk:"name"`
+	Ttl          types.Int64  `tfsdk:"ttl"`
+	UseTtl       types.Bool   `tfsdk:"use_ttl"`
+	View         types.String `tfsdk:"view"`
+	Zone         types.String `tfsdk:"zone"`
+}
+
+var RecordDsAttrTypes = map[string]attr.Type{
+	"ref":           types.StringType,
+	"algorithm":     types.StringType,
+	"cloud_info":    types.ObjectType{AttrTypes: RecordDsCloudInfoAttrTypes},
+	"comment":       types.StringType,
+	"creation_time": types.Int64Type,
+	"creator":       types.StringType,
+	"digest":        types.StringType,
+	"digest_type":   types.StringType,
+	"dns_name":      types.StringType,
+	"key_tag":       types.Int64Type,
+	"last_queried":  types.Int64Type,
+	"name":          types.StringType,
+	"ttl":           types.Int64Type,
+	"use_ttl":       types.BoolType,
+	"view":          types.StringType,
+	"zone":          types.StringType,
+}
+
+var RecordDsResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"algorithm": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The algorithm of the DNSKEY RR to which this DS RR refers. It uses the same algorithm values and types as the corresponding DNSKEY RR.",
+	},
+	"cloud_info": schema.SingleNestedAttribute{
+		Attributes:          RecordDsCloudInfoResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The cloud information associated with the record.",
+	},
+	"comment": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The comment for the record.",
+	},
+	"creation_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The creation time of the record.",
+	},
+	"creator": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Creator of the record.",
+	},
+	"digest": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The digest of the DNSKEY resource record that is stored in a DS Record object.",
+	},
+	"digest_type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The algorithm used to construct the digest.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name for the DS record in punycode format.",
+	},
+	"key_tag": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The key tag value that is used to determine which key to use to verify signatures.",
+	},
+	"last_queried": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DNS DS record in FQDN format.",
+	},
+	"ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The Time To Live (TTL) value for the record. A 32-bit unsigned integer that represents the duration, in seconds, for which the record is valid (cached). Zero indicates that the record should not be cached.",
+	},
+	"use_ttl": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Use flag for: ttl",
+	},
+	"view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DNS View in which the record resides. Example: \"external\".",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in which the record resides. Example: \"zone.com\". If a view is not specified when searching by zone, the default view is used.",
+	},
+}
+
+func FlattenRecordDs(ctx context.Context, from *dns.RecordDs, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordDsAttrTypes)
+	}
+	m := RecordDsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordDsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordDsModel) Flatten(ctx context.Context, from *dns.RecordDs, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordDsModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Algorithm = flex.FlattenStringPointer(from.Algorithm)
+	m.CloudInfo = FlattenRecordDsCloudInfo(ctx, from.CloudInfo, diags)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.CreationTime = flex.FlattenInt64Pointer(from.CreationTime)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.Digest = flex.FlattenStringPointer(from.Digest)
+	m.DigestType = flex.FlattenStringPointer(from.DigestType)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.KeyTag = flex.FlattenInt64Pointer(from.KeyTag)
+	m.LastQueried = flex.FlattenInt64Pointer(from.LastQueried)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Ttl = flex.FlattenInt64Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
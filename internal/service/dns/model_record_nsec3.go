@@ -0,0 +1,164 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type RecordNsec3Model struct {
+	Ref           types.String `tfsdk:"ref"`
+	Algorithm     types.String `tfsdk:"algorithm"`
+	CloudInfo     types.Object `tfsdk:"cloud_info"`
+	CreationTime  types.Int64  `tfsdk:"creation_time"`
+	Creator       types.String `tfsdk:"creator"`
+	DnsName       types.String `tfsdk:"dns_name"`
+	Flags         types.Int64  `tfsdk:"flags"`
+	Iterations    types.Int64  `tfsdk:"iterations"`
+	LastQueried   types.Int64  `tfsdk:"last_queried"`
+	Name          types.String `tfsdk:"name"`
+	NextOwnerName types.String `tfsdk:"next_owner_name"`
+	RrsetTypes    types.List   `tfsdk:"rrset_types"`
+	Salt          types.String `tfsdk:"salt"`
+	Ttl           types.Int64  `tfsdk:"ttl"`
+	UseTtl        types.Bool   `tfsdk:"use_ttl"`
+	View          types.String `tfsdk:"view"`
+	Zone          types.String `tfsdk:"zone"`
+}
+
+var RecordNsec3AttrTypes = map[string]attr.Type{
+	"ref":             types.StringType,
+	"algorithm":       types.StringType,
+	"cloud_info":      types.ObjectType{AttrTypes: RecordNsec3CloudInfoAttrTypes},
+	"creation_time":   types.Int64Type,
+	"creator":         types.StringType,
+	"dns_name":        types.StringType,
+	"flags":           types.Int64Type,
+	"iterations":      types.Int64Type,
+	"last_queried":    types.Int64Type,
+	"name":            types.StringType,
+	"next_owner_name": types.StringType,
+	"rrset_types":     types.ListType{ElemType: types.StringType},
+	"salt":            types.StringType,
+	"ttl":             types.Int64Type,
+	"use_ttl":         types.BoolType,
+	"view":            types.StringType,
+	"zone":            types.StringType,
+}
+
+var RecordNsec3ResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"algorithm": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The hash algorithm that was used.",
+	},
+	"cloud_info": schema.SingleNestedAttribute{
+		Attributes:          RecordNsec3CloudInfoResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The cloud information associated with the record.",
+	},
+	"creation_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The creation time of the record.",
+	},
+	"creator": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Creator of the record.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Name for an NSEC3 record in punycode format.",
+	},
+	"flags": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The set of 8 one-bit flags, of which only one flag, the Opt-Out flag, is defined by RFC 5155. The Opt-Out flag indicates whether the NSEC3 record covers unsigned delegations.",
+	},
+	"iterations": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of times the hash function was performed.",
+	},
+	"last_queried": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the NSEC3 record in FQDN format.",
+	},
+	"next_owner_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The hashed next owner name that has authoritative data or that contains a delegation point NS record.",
+	},
+	"rrset_types": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "The RRSet types that exist at the original owner name of the NSEC3 RR.",
+	},
+	"salt": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "A series of case-insensitive hexadecimal digits. It is appended to the original owner name as protection against pre-calculated dictionary attacks. A new salt value is generated when ZSK rolls over. You can control the period of the rollover. For random salt values, the selected length is between one and 15 octets.",
+	},
+	"ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The Time To Live (TTL) value for the record. A 32-bit unsigned integer that represents the duration, in seconds, for which the record is valid (cached). Zero indicates that the record should not be cached.",
+	},
+	"use_ttl": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Use flag for: ttl",
+	},
+	"view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DNS View in which the record resides. Example: \"external\".",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in which the record resides. Example: \"zone.com\". If a view is not specified when searching by zone, the default view is used.",
+	},
+}
+
+func FlattenRecordNsec3(ctx context.Context, from *dns.RecordNsec3, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordNsec3AttrTypes)
+	}
+	m := RecordNsec3Model{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordNsec3AttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordNsec3Model) Flatten(ctx context.Context, from *dns.RecordNsec3, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordNsec3Model{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Algorithm = flex.FlattenStringPointer(from.Algorithm)
+	m.CloudInfo = FlattenRecordNsec3CloudInfo(ctx, from.CloudInfo, diags)
+	m.CreationTime = flex.FlattenInt64Pointer(from.CreationTime)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.Flags = flex.FlattenInt64Pointer(from.Flags)
+	m.Iterations = flex.FlattenInt64Pointer(from.Iterations)
+	m.LastQueried = flex.FlattenInt64Pointer(from.LastQueried)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.NextOwnerName = flex.FlattenStringPointer(from.NextOwnerName)
+	m.RrsetTypes = flex.FlattenFrameworkListString(ctx, from.RrsetTypes, diags)
+	m.Salt = flex.FlattenStringPointer(from.Salt)
+	m.Ttl = flex.FlattenInt64Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,101 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type RecordnamepolicyModel struct {
+	Ref        types.String `tfsdk:"ref"`
+	IsDefault  types.Bool   `tfsdk:"is_default"`
+	Name       types.String `tfsdk:"name"`
+	PreDefined types.Bool   `tfsdk:"pre_defined"`
+	Regex      types.String `tfsdk:"regex"`
+}
+
+var RecordnamepolicyAttrTypes = map[string]attr.Type{
+	"ref":         types.StringType,
+	"is_default":  types.BoolType,
+	"name":        types.StringType,
+	"pre_defined": types.BoolType,
+	"regex":       types.StringType,
+}
+
+var RecordnamepolicyResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"is_default": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines whether the record name policy is Grid default.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the record name policy object. Zones reference the policy by this name in `record_name_policy`.",
+	},
+	"pre_defined": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines whether the record name policy is a predefined one.",
+	},
+	"regex": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.IsValidRegex(),
+		},
+		MarkdownDescription: "The POSIX regular expression the record names should match in order to comply with the record name policy. Each label of a record name is matched separately.",
+	},
+}
+
+func (m *RecordnamepolicyModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.Recordnamepolicy {
+	if m == nil {
+		return nil
+	}
+	to := &dns.Recordnamepolicy{
+		IsDefault: flex.ExpandBoolPointer(m.IsDefault),
+		Name:      flex.ExpandStringPointer(m.Name),
+		Regex:     flex.ExpandStringPointer(m.Regex),
+	}
+	return to
+}
+
+func FlattenRecordnamepolicy(ctx context.Context, from *dns.Recordnamepolicy, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordnamepolicyAttrTypes)
+	}
+	m := RecordnamepolicyModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordnamepolicyAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordnamepolicyModel) Flatten(ctx context.Context, from *dns.Recordnamepolicy, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordnamepolicyModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.IsDefault = types.BoolPointerValue(from.IsDefault)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.PreDefined = types.BoolPointerValue(from.PreDefined)
+	m.Regex = flex.FlattenStringPointer(from.Regex)
+}
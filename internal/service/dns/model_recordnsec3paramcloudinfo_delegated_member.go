@@ -0,0 +1,64 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type Recordnsec3paramcloudinfoDelegatedMemberModel struct {
+	Ipv4addr types.String `tfsdk:"ipv4addr"`
+	Ipv6addr types.String `tfsdk:"ipv6addr"`
+	Name     types.String `tfsdk:"name"`
+}
+
+var Recordnsec3paramcloudinfoDelegatedMemberAttrTypes = map[string]attr.Type{
+	"ipv4addr": types.StringType,
+	"ipv6addr": types.StringType,
+	"name":     types.StringType,
+}
+
+var Recordnsec3paramcloudinfoDelegatedMemberResourceSchemaAttributes = map[string]schema.Attribute{
+	"ipv4addr": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The IPv4 Address of the Grid Member.",
+	},
+	"ipv6addr": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The IPv6 Address of the Grid Member.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The Grid member name",
+	},
+}
+
+func FlattenRecordnsec3paramcloudinfoDelegatedMember(ctx context.Context, from *dns.Recordnsec3paramcloudinfoDelegatedMember, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(Recordnsec3paramcloudinfoDelegatedMemberAttrTypes)
+	}
+	m := Recordnsec3paramcloudinfoDelegatedMemberModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, Recordnsec3paramcloudinfoDelegatedMemberAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *Recordnsec3paramcloudinfoDelegatedMemberModel) Flatten(ctx context.Context, from *dns.Recordnsec3paramcloudinfoDelegatedMember, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = Recordnsec3paramcloudinfoDelegatedMemberModel{}
+	}
+	m.Ipv4addr = flex.FlattenStringPointer(from.Ipv4addr)
+	m.Ipv6addr = flex.FlattenStringPointer(from.Ipv6addr)
+	m.Name = flex.FlattenStringPointer(from.Name)
+}
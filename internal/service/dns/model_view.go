@@ -293,7 +293,7 @@ var ViewResourceSchemaAttributes = map[string]schema.Attribute{
 		Validators: []validator.String{
 			stringvalidator.AlsoRequires(path.MatchRoot("use_ddns_principal_security")),
 		},
-		MarkdownDescription: "The DDNS Principal cluster group name.",
+		MarkdownDescription: "The name of the DDNS Principal cluster group, as managed by `nios_dns_ddns_principalcluster_group`.",
 	},
 	"ddns_principal_tracking": schema.BoolAttribute{
 		Optional: true,
@@ -372,7 +372,7 @@ var ViewResourceSchemaAttributes = map[string]schema.Attribute{
 			listvalidator.SizeAtLeast(1),
 		},
 		Optional:            true,
-		MarkdownDescription: "The list of DNS64 synthesis groups associated with this DNS view.",
+		MarkdownDescription: "The names of the DNS64 synthesis groups associated with this DNS view, as managed by `nios_dns_dns64group`.",
 	},
 	"dnssec_enabled": schema.BoolAttribute{
 		Optional: true,
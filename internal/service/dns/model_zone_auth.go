@@ -153,6 +153,7 @@ type ZoneAuthModel struct {
 	UseSoaEmail                             types.Bool                               `tfsdk:"use_soa_email"`
 	UsingSrgAssociations                    types.Bool                               `tfsdk:"using_srg_associations"`
 	View                                    types.String                             `tfsdk:"view"`
+	WarnOnDiscrepancies                     types.Bool                               `tfsdk:"warn_on_discrepancies"`
 	ZoneFormat                              types.String                             `tfsdk:"zone_format"`
 	ZoneNotQueriedEnabledTime               types.Int64                              `tfsdk:"zone_not_queried_enabled_time"`
 }
@@ -274,6 +275,7 @@ var ZoneAuthAttrTypes = map[string]attr.Type{
 	"use_soa_email":                            types.BoolType,
 	"using_srg_associations":                   types.BoolType,
 	"view":                                     types.StringType,
+	"warn_on_discrepancies":                    types.BoolType,
 	"zone_format":                              types.StringType,
 	"zone_not_queried_enabled_time":            types.Int64Type,
 }
@@ -427,7 +429,7 @@ var ZoneAuthResourceSchemaAttributes = map[string]schema.Attribute{
 	},
 	"ddns_principal_group": schema.StringAttribute{
 		Optional:            true,
-		MarkdownDescription: "The DDNS Principal cluster group name.",
+		MarkdownDescription: "The name of the DDNS Principal cluster group, as managed by `nios_dns_ddns_principalcluster_group`.",
 		Validators: []validator.String{
 			stringvalidator.AlsoRequires(path.MatchRoot("use_ddns_principal_security")),
 		},
@@ -1139,6 +1141,13 @@ var ZoneAuthResourceSchemaAttributes = map[string]schema.Attribute{
 		Default:             stringdefault.StaticString("default"),
 		MarkdownDescription: "The name of the DNS view in which the zone resides. Example \"external\".",
 	},
+	"warn_on_discrepancies": schema.BoolAttribute{
+		Optional: true,
+		MarkdownDescription: "Flag to check the zone for data discrepancies between its primary and secondary servers after it is updated. " +
+			"A warning is raised for each discrepancy found by the last scheduled DNS integrity check of the zone, no check is started by the provider. " +
+			"No warning is raised when the zone is created, as it has not been checked yet. " +
+			"The DNS integrity check is configured with the `dns_integrity_*` attributes.",
+	},
 	"zone_format": schema.StringAttribute{
 		Optional: true,
 		Computed: true,
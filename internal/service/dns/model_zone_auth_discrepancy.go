@@ -0,0 +1,78 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type ZoneAuthDiscrepancyModel struct {
+	Ref         types.String `tfsdk:"ref"`
+	Description types.String `tfsdk:"description"`
+	Severity    types.String `tfsdk:"severity"`
+	Timestamp   types.Int64  `tfsdk:"timestamp"`
+	Zone        types.String `tfsdk:"zone"`
+}
+
+var ZoneAuthDiscrepancyAttrTypes = map[string]attr.Type{
+	"ref":         types.StringType,
+	"description": types.StringType,
+	"severity":    types.StringType,
+	"timestamp":   types.Int64Type,
+	"zone":        types.StringType,
+}
+
+var ZoneAuthDiscrepancyResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"description": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Information about the discrepancy.",
+	},
+	"severity": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The severity of the discrepancy reported. One of `FATAL`, `WARNING` or `INFORMATIONAL`.",
+	},
+	"timestamp": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time when the DNS integrity check was last run for this zone.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference of the zone to which the discrepancy refers.",
+	},
+}
+
+func FlattenZoneAuthDiscrepancy(ctx context.Context, from *dns.ZoneAuthDiscrepancy, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ZoneAuthDiscrepancyAttrTypes)
+	}
+	m := ZoneAuthDiscrepancyModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ZoneAuthDiscrepancyAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ZoneAuthDiscrepancyModel) Flatten(ctx context.Context, from *dns.ZoneAuthDiscrepancy, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ZoneAuthDiscrepancyModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Description = flex.FlattenStringPointer(from.Description)
+	m.Severity = flex.FlattenStringPointer(from.Severity)
+	m.Timestamp = flex.FlattenInt64Pointer(from.Timestamp)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -385,7 +385,7 @@ var ZoneRpResourceSchemaAttributes = map[string]schema.Attribute{
 	},
 	"rpz_priority": schema.Int64Attribute{
 		Computed:            true,
-		MarkdownDescription: "The priority of this response policy zone.",
+		MarkdownDescription: "The priority of this response policy zone. The order of the response policy zones of a view is managed with `nios_dns_orderedresponsepolicyzones`.",
 	},
 	"rpz_priority_end": schema.Int64Attribute{
 		Computed:            true,
@@ -0,0 +1,58 @@
+package dns_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccOrderedresponsepolicyzonesDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_orderedresponsepolicyzones.test"
+	resourceName := "nios_dns_orderedresponsepolicyzones.test"
+	var v dns.Orderedresponsepolicyzones
+	view := acctest.RandomNameWithPrefix("view")
+	zone1 := acctest.RandomNameWithPrefix("rpz") + ".com"
+	zone2 := acctest.RandomNameWithPrefix("rpz") + ".com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderedresponsepolicyzonesDataSourceConfigFilters(view, zone1, zone2),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckOrderedresponsepolicyzonesExists(context.Background(), resourceName, &v),
+					}, testAccCheckOrderedresponsepolicyzonesResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckOrderedresponsepolicyzonesResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "rp_zones", dataSourceName, "result.0.rp_zones"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccOrderedresponsepolicyzonesDataSourceConfigFilters(view, zone1, zone2 string) string {
+	config := `
+data "nios_dns_orderedresponsepolicyzones" "test" {
+  filters = {
+    view = nios_dns_orderedresponsepolicyzones.test.view
+  }
+}
+`
+	return strings.Join([]string{testAccOrderedresponsepolicyzonesBasicConfig(view, zone1, zone2, "zone1", "zone2"), config}, "")
+}
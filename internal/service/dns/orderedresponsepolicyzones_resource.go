@@ -0,0 +1,308 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForOrderedresponsepolicyzones = "rp_zones,view"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrderedresponsepolicyzonesResource{}
+var _ resource.ResourceWithImportState = &OrderedresponsepolicyzonesResource{}
+
+func NewOrderedresponsepolicyzonesResource() resource.Resource {
+	return &OrderedresponsepolicyzonesResource{}
+}
+
+// OrderedresponsepolicyzonesResource defines the resource implementation.
+type OrderedresponsepolicyzonesResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *OrderedresponsepolicyzonesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_orderedresponsepolicyzones"
+}
+
+func (r *OrderedresponsepolicyzonesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the order in which the Response Policy Zones of a DNS view are evaluated. The list must contain every Response Policy Zone of the view, " +
+			"zones that are reordered, added or removed outside of Terraform are shown as changes. Destroying the resource only removes it from the Terraform state, the Grid keeps the order.",
+		Attributes: OrderedresponsepolicyzonesResourceSchemaAttributes,
+	}
+}
+
+func (r *OrderedresponsepolicyzonesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrderedresponsepolicyzonesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrderedresponsepolicyzonesModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, _, err := r.client.DNSAPI.
+		OrderedresponsepolicyzonesAPI.
+		List(ctx).
+		Filters(map[string]any{"view": data.View.ValueString()}).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForOrderedresponsepolicyzones).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Orderedresponsepolicyzones, got error: %s", err))
+		return
+	}
+
+	list := listResp.ListOrderedresponsepolicyzonesResponseObject.GetResult()
+
+	if len(list) == 0 {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("No Orderedresponsepolicyzones object exists for DNS view %s", data.View.ValueString()))
+		return
+	}
+
+	// Each DNS view has exactly one ordered list of Response Policy Zones
+	data.Ref = flex.FlattenStringPointer(list[0].Ref)
+
+	r.update(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderedresponsepolicyzonesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrderedresponsepolicyzonesModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *dns.GetOrderedresponsepolicyzonesResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.DNSAPI.
+			OrderedresponsepolicyzonesAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForOrderedresponsepolicyzones).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Orderedresponsepolicyzones, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetOrderedresponsepolicyzonesResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderedresponsepolicyzonesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrderedresponsepolicyzonesModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderedresponsepolicyzonesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The order of the Response Policy Zones cannot be deleted, so just clear state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *OrderedresponsepolicyzonesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
+
+// update sets the planned order of the Response Policy Zones referenced by data.Ref.
+func (r *OrderedresponsepolicyzonesResource) update(ctx context.Context, data *OrderedresponsepolicyzonesModel, diags *diag.Diagnostics) {
+	payload := data.Expand(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Zones created in the same apply exist by now, so the list is checked against the zones of the view
+	// to report missing or unknown zones by name instead of failing with a generic WAPI error.
+	r.checkRpZones(ctx, data.View.ValueString(), payload.RpZones, diags)
+	if diags.HasError() {
+		return
+	}
+
+	var apiRes *dns.UpdateOrderedresponsepolicyzonesResponse
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.DNSAPI.
+			OrderedresponsepolicyzonesAPI.
+			Update(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+			Orderedresponsepolicyzones(*payload).
+			ReturnFieldsPlus(readableAttributesForOrderedresponsepolicyzones).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update Orderedresponsepolicyzones, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateOrderedresponsepolicyzonesResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, diags)
+}
+
+// checkRpZones verifies that rpZones contains every Response Policy Zone of the view and nothing else.
+func (r *OrderedresponsepolicyzonesResource) checkRpZones(ctx context.Context, view string, rpZones []string, diags *diag.Diagnostics) {
+	zones, err := utils.ReadWithPages(
+		func(pageID string, maxResults int32) ([]dns.ZoneRp, string, error) {
+			request := r.client.DNSAPI.
+				ZoneRpAPI.
+				List(ctx).
+				Filters(map[string]any{"view": view}).
+				ReturnFields("fqdn").
+				ReturnAsObject(1).
+				Paging(1).
+				MaxResults(maxResults).
+				ProxySearch(config.GetProxySearch())
+
+			if pageID != "" {
+				request = request.PageId(pageID)
+			}
+
+			apiRes, _, err := request.Execute()
+			if err != nil {
+				return nil, "", err
+			}
+
+			var nextPageID string
+			if npId, ok := apiRes.ListZoneRpResponseObject.AdditionalProperties["next_page_id"].(string); ok {
+				nextPageID = npId
+			}
+			return apiRes.ListZoneRpResponseObject.GetResult(), nextPageID, nil
+		},
+	)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list Response Policy Zones of DNS view %s, got error: %s", view, err))
+		return
+	}
+
+	var existing []string
+	for _, zone := range zones {
+		existing = append(existing, zone.GetFqdn())
+	}
+
+	var missing, unknown []string
+	for _, zone := range existing {
+		if !slices.Contains(rpZones, zone) {
+			missing = append(missing, zone)
+		}
+	}
+	for _, zone := range rpZones {
+		if !slices.Contains(existing, zone) {
+			unknown = append(unknown, zone)
+		}
+	}
+
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("rp_zones"),
+			"Incomplete Response Policy Zone Order",
+			fmt.Sprintf("rp_zones must contain every Response Policy Zone of DNS view %s, missing: %s", view, strings.Join(missing, ", ")),
+		)
+	}
+	if len(unknown) > 0 {
+		diags.AddAttributeError(
+			path.Root("rp_zones"),
+			"Unknown Response Policy Zone",
+			fmt.Sprintf("The following Response Policy Zones do not exist in DNS view %s: %s", view, strings.Join(unknown, ", ")),
+		)
+	}
+}
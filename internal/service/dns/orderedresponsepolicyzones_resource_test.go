@@ -0,0 +1,246 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForOrderedresponsepolicyzones = "rp_zones,view"
+
+func TestAccOrderedresponsepolicyzonesResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_orderedresponsepolicyzones.test"
+	var v dns.Orderedresponsepolicyzones
+	view := acctest.RandomNameWithPrefix("view")
+	zone1 := acctest.RandomNameWithPrefix("rpz") + ".com"
+	zone2 := acctest.RandomNameWithPrefix("rpz") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccOrderedresponsepolicyzonesBasicConfig(view, zone1, zone2, "zone1", "zone2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedresponsepolicyzonesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "view", view),
+					resource.TestCheckResourceAttr(resourceName, "rp_zones.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rp_zones.0", zone1),
+					resource.TestCheckResourceAttr(resourceName, "rp_zones.1", zone2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrderedresponsepolicyzonesResource_Import(t *testing.T) {
+	var resourceName = "nios_dns_orderedresponsepolicyzones.test"
+	var v dns.Orderedresponsepolicyzones
+	view := acctest.RandomNameWithPrefix("view")
+	zone1 := acctest.RandomNameWithPrefix("rpz") + ".com"
+	zone2 := acctest.RandomNameWithPrefix("rpz") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderedresponsepolicyzonesBasicConfig(view, zone1, zone2, "zone1", "zone2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedresponsepolicyzonesExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccOrderedresponsepolicyzonesImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func TestAccOrderedresponsepolicyzonesResource_RpZones(t *testing.T) {
+	var resourceName = "nios_dns_orderedresponsepolicyzones.test"
+	var v dns.Orderedresponsepolicyzones
+	view := acctest.RandomNameWithPrefix("view")
+	zone1 := acctest.RandomNameWithPrefix("rpz") + ".com"
+	zone2 := acctest.RandomNameWithPrefix("rpz") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccOrderedresponsepolicyzonesBasicConfig(view, zone1, zone2, "zone1", "zone2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedresponsepolicyzonesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rp_zones.0", zone1),
+					resource.TestCheckResourceAttr(resourceName, "rp_zones.1", zone2),
+					resource.TestCheckResourceAttr("nios_dns_zone_rp.zone1", "rpz_priority", "0"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccOrderedresponsepolicyzonesBasicConfig(view, zone1, zone2, "zone2", "zone1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedresponsepolicyzonesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rp_zones.0", zone2),
+					resource.TestCheckResourceAttr(resourceName, "rp_zones.1", zone1),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrderedresponsepolicyzonesResource_Drift(t *testing.T) {
+	var resourceName = "nios_dns_orderedresponsepolicyzones.test"
+	var v dns.Orderedresponsepolicyzones
+	view := acctest.RandomNameWithPrefix("view")
+	zone1 := acctest.RandomNameWithPrefix("rpz") + ".com"
+	zone2 := acctest.RandomNameWithPrefix("rpz") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Reorder the zones outside of Terraform
+			{
+				Config: testAccOrderedresponsepolicyzonesBasicConfig(view, zone1, zone2, "zone1", "zone2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedresponsepolicyzonesExists(context.Background(), resourceName, &v),
+					testAccCheckOrderedresponsepolicyzonesReorder(context.Background(), &v, []string{zone2, zone1}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// The configured order is restored
+			{
+				Config: testAccOrderedresponsepolicyzonesBasicConfig(view, zone1, zone2, "zone1", "zone2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderedresponsepolicyzonesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rp_zones.0", zone1),
+					resource.TestCheckResourceAttr(resourceName, "rp_zones.1", zone2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrderedresponsepolicyzonesResource_MissingZone(t *testing.T) {
+	view := acctest.RandomNameWithPrefix("view")
+	zone1 := acctest.RandomNameWithPrefix("rpz") + ".com"
+	zone2 := acctest.RandomNameWithPrefix("rpz") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrderedresponsepolicyzonesMissingZone(view, zone1, zone2),
+				ExpectError: regexp.MustCompile("rp_zones must contain every Response Policy Zone"),
+			},
+		},
+	})
+}
+
+func testAccCheckOrderedresponsepolicyzonesExists(ctx context.Context, resourceName string, v *dns.Orderedresponsepolicyzones) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			OrderedresponsepolicyzonesAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForOrderedresponsepolicyzones).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetOrderedresponsepolicyzonesResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetOrderedresponsepolicyzonesResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckOrderedresponsepolicyzonesReorder(ctx context.Context, v *dns.Orderedresponsepolicyzones, rpZones []string) resource.TestCheckFunc {
+	// Reorder the Response Policy Zones externally to verify drift detection
+	return func(state *terraform.State) error {
+		_, _, err := acctest.NIOSClient.DNSAPI.
+			OrderedresponsepolicyzonesAPI.
+			Update(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Orderedresponsepolicyzones(dns.Orderedresponsepolicyzones{RpZones: rpZones}).
+			Execute()
+		return err
+	}
+}
+
+func testAccOrderedresponsepolicyzonesImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccOrderedresponsepolicyzonesZones(view, zone1, zone2 string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test" {
+  name = %q
+}
+
+resource "nios_dns_zone_rp" "zone1" {
+  fqdn = %q
+  view = nios_dns_view.test.name
+}
+
+resource "nios_dns_zone_rp" "zone2" {
+  fqdn = %q
+  view = nios_dns_view.test.name
+}
+`, view, zone1, zone2)
+}
+
+func testAccOrderedresponsepolicyzonesBasicConfig(view, zone1, zone2, first, second string) string {
+	config := fmt.Sprintf(`
+resource "nios_dns_orderedresponsepolicyzones" "test" {
+  view     = nios_dns_view.test.name
+  rp_zones = [nios_dns_zone_rp.%s.fqdn, nios_dns_zone_rp.%s.fqdn]
+}
+`, first, second)
+	return strings.Join([]string{testAccOrderedresponsepolicyzonesZones(view, zone1, zone2), config}, "")
+}
+
+func testAccOrderedresponsepolicyzonesMissingZone(view, zone1, zone2 string) string {
+	config := `
+resource "nios_dns_orderedresponsepolicyzones" "test" {
+  view     = nios_dns_view.test.name
+  rp_zones = [nios_dns_zone_rp.zone1.fqdn]
+
+  depends_on = [nios_dns_zone_rp.zone2]
+}
+`
+	return strings.Join([]string{testAccOrderedresponsepolicyzonesZones(view, zone1, zone2), config}, "")
+}
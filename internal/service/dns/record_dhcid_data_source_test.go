@@ -0,0 +1,47 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO: OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// -> DHCID Record: dhcid.example.com, added by a DDNS update in zone example.com (in default view)
+
+func TestAccRecordDhcidDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_dhcid.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordDhcidDataSourceConfigFilters("dhcid.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", "dhcid.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", "example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.dhcid"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ttl"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordDhcidDataSourceConfigFilters(name string) string {
+	return fmt.Sprintf(`
+data "nios_dns_record_dhcid" "test" {
+  filters = {
+	name = %q
+  }
+}
+`, name)
+}
@@ -0,0 +1,48 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO: OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// -> Signed Zone: dnssec.example.com (in default view)
+
+func TestAccRecordDnskeyDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_dnskey.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordDnskeyDataSourceConfigFilters("dnssec.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", "dnssec.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", "dnssec.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.algorithm"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.key_tag"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.public_key"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordDnskeyDataSourceConfigFilters(zone string) string {
+	return fmt.Sprintf(`
+data "nios_dns_record_dnskey" "test" {
+  filters = {
+	zone = %q
+  }
+}
+`, zone)
+}
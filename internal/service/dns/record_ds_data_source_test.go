@@ -0,0 +1,50 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO: OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// -> Signed Zone: dnssec.example.com (in default view)
+// -> Parent Zone: example.com (in default view)
+
+func TestAccRecordDsDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_ds.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordDsDataSourceConfigFilters("dnssec.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", "dnssec.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", "example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.algorithm"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.digest"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.digest_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.key_tag"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordDsDataSourceConfigFilters(name string) string {
+	return fmt.Sprintf(`
+data "nios_dns_record_ds" "test" {
+  filters = {
+	name = %q
+  }
+}
+`, name)
+}
@@ -0,0 +1,47 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO: OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// -> Zone: nsec3.example.com (in default view), signed with NSEC3
+
+func TestAccRecordNsec3DataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_nsec3.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordNsec3DataSourceConfigFilters("nsec3.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", "nsec3.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.algorithm"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.iterations"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.next_owner_name"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordNsec3DataSourceConfigFilters(zone string) string {
+	return fmt.Sprintf(`
+data "nios_dns_record_nsec3" "test" {
+  filters = {
+	zone = %q
+  }
+}
+`, zone)
+}
@@ -0,0 +1,46 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO: OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// -> Zone: dnssec.example.com (in default view), signed with NSEC
+
+func TestAccRecordNsecDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_nsec.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordNsecDataSourceConfigFilters("dnssec.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", "dnssec.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.next_owner_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.rrset_types.#"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordNsecDataSourceConfigFilters(zone string) string {
+	return fmt.Sprintf(`
+data "nios_dns_record_nsec" "test" {
+  filters = {
+	zone = %q
+  }
+}
+`, zone)
+}
@@ -0,0 +1,51 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO: OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// -> Signed Zone: dnssec.example.com (in default view)
+
+func TestAccRecordRrsigDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_rrsig.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordRrsigDataSourceConfigFilters("dnssec.example.com", "SOA"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", "dnssec.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.type_covered", "SOA"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", "dnssec.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.signature"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.signer_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.expiration_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.inception_time"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordRrsigDataSourceConfigFilters(zone, typeCovered string) string {
+	return fmt.Sprintf(`
+data "nios_dns_record_rrsig" "test" {
+  filters = {
+	zone = %q
+	type_covered = %q
+  }
+}
+`, zone, typeCovered)
+}
@@ -0,0 +1,301 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForRecordnamepolicy = "is_default,name,pre_defined,regex"
+
+func TestAccRecordnamepolicyResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_recordnamepolicy.test"
+	var v dns.Recordnamepolicy
+	name := acctest.RandomNameWithPrefix("record-name-policy")
+	regex := "^[a-z0-9-]+$"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnamepolicyBasicConfig(name, regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "regex", regex),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "pre_defined", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnamepolicyResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_recordnamepolicy.test"
+	var v dns.Recordnamepolicy
+	name := acctest.RandomNameWithPrefix("record-name-policy")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordnamepolicyDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordnamepolicyBasicConfig(name, "^[a-z0-9-]+$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					testAccCheckRecordnamepolicyDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordnamepolicyResource_Import(t *testing.T) {
+	var resourceName = "nios_dns_recordnamepolicy.test"
+	var v dns.Recordnamepolicy
+	name := acctest.RandomNameWithPrefix("record-name-policy")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordnamepolicyBasicConfig(name, "^[a-z0-9-]+$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordnamepolicyImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func TestAccRecordnamepolicyResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_recordnamepolicy.test_name"
+	var v dns.Recordnamepolicy
+	name := acctest.RandomNameWithPrefix("record-name-policy")
+	nameUpdate := acctest.RandomNameWithPrefix("record-name-policy")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnamepolicyName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnamepolicyName(nameUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", nameUpdate),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnamepolicyResource_Regex(t *testing.T) {
+	var resourceName = "nios_dns_recordnamepolicy.test_regex"
+	var v dns.Recordnamepolicy
+	name := acctest.RandomNameWithPrefix("record-name-policy")
+	regex := "^[a-z0-9-]+$"
+	regexUpdate := "^[-a-zA-Z0-9_]+$"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid regular expressions are rejected at plan time
+			{
+				Config:      testAccRecordnamepolicyRegex(name, "^[a-z+$"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+			// Create and Read
+			{
+				Config: testAccRecordnamepolicyRegex(name, regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regex", regex),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnamepolicyRegex(name, regexUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regex", regexUpdate),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnamepolicyResource_ZoneReference(t *testing.T) {
+	var resourceName = "nios_dns_recordnamepolicy.test_zone_reference"
+	var zoneResourceName = "nios_dns_zone_auth.test_zone_reference"
+	var v dns.Recordnamepolicy
+	var zone dns.ZoneAuth
+	name := acctest.RandomNameWithPrefix("record-name-policy")
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnamepolicyZoneReference(name, zoneFqdn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					testAccCheckZoneAuthExists(context.Background(), zoneResourceName, &zone),
+					resource.TestCheckResourceAttrPair(zoneResourceName, "record_name_policy", resourceName, "name"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckRecordnamepolicyExists(ctx context.Context, resourceName string, v *dns.Recordnamepolicy) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordnamepolicyAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForRecordnamepolicy).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetRecordnamepolicyResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetRecordnamepolicyResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordnamepolicyDestroy(ctx context.Context, v *dns.Recordnamepolicy) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordnamepolicyAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForRecordnamepolicy).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordnamepolicyDisappears(ctx context.Context, v *dns.Recordnamepolicy) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordnamepolicyAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordnamepolicyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccRecordnamepolicyBasicConfig(name, regex string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_recordnamepolicy" "test" {
+    name = %q
+    regex = %q
+}
+`, name, regex)
+}
+
+func testAccRecordnamepolicyName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_recordnamepolicy" "test_name" {
+    name = %q
+    regex = "^[a-z0-9-]+$"
+}
+`, name)
+}
+
+func testAccRecordnamepolicyRegex(name, regex string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_recordnamepolicy" "test_regex" {
+    name = %q
+    regex = %q
+}
+`, name, regex)
+}
+
+func testAccRecordnamepolicyZoneReference(name, zoneFqdn string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_recordnamepolicy" "test_zone_reference" {
+    name = %q
+    regex = "^[a-z0-9-]+$"
+}
+
+resource "nios_dns_zone_auth" "test_zone_reference" {
+    fqdn = %q
+    view = "default"
+    record_name_policy = nios_dns_recordnamepolicy.test_zone_reference.name
+    use_record_name_policy = true
+}
+`, name, zoneFqdn)
+}
@@ -0,0 +1,51 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccZoneAuthDiscrepancyDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_auth_discrepancy.test"
+	zoneResourceName := "nios_dns_zone_auth.test"
+	var v dns.ZoneAuth
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneAuthDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneAuthDiscrepancyDataSourceConfigFilters(zoneFqdn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), zoneResourceName, &v),
+					// The DNS integrity check has not run yet for a new zone, so no discrepancy is reported
+					resource.TestCheckNoResourceAttr(dataSourceName, "result.#"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccZoneAuthDiscrepancyDataSourceConfigFilters(zoneFqdn string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test" {
+  fqdn = %q
+}
+
+data "nios_dns_zone_auth_discrepancy" "test" {
+  filters = {
+	zone = nios_dns_zone_auth.test.ref
+  }
+}
+`, zoneFqdn)
+}
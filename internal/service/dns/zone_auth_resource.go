@@ -491,6 +491,10 @@ func (r *ZoneAuthResource) Update(ctx context.Context, req resource.UpdateReques
 
 	data.Flatten(ctx, &res, &resp.Diagnostics)
 
+	if data.WarnOnDiscrepancies.ValueBool() {
+		r.warnOnDiscrepancies(ctx, &data, &resp.Diagnostics)
+	}
+
 	// Save the Identity of the Resource
 	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("ref"), &data.Ref)...)
 
@@ -545,3 +549,28 @@ func (r *ZoneAuthResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ref"), req.ID)...)
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "associate_internal_id", []byte("true"))...)
 }
+
+// warnOnDiscrepancies adds a warning for each data discrepancy reported for the zone between its primary and secondary servers.
+func (r *ZoneAuthResource) warnOnDiscrepancies(ctx context.Context, data *ZoneAuthModel, diags *diag.Diagnostics) {
+	apiRes, _, err := r.client.DNSAPI.
+		ZoneAuthDiscrepancyAPI.
+		List(ctx).
+		Filters(map[string]interface{}{
+			"zone": data.Ref.ValueString(),
+		}).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForZoneAuthDiscrepancy).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		diags.AddWarning("Client Error", fmt.Sprintf("Unable to check ZoneAuth %s for discrepancies, got error: %s", data.Fqdn.ValueString(), err))
+		return
+	}
+
+	for _, discrepancy := range apiRes.ListZoneAuthDiscrepancyResponseObject.GetResult() {
+		diags.AddWarning(
+			"Zone Discrepancy Found",
+			fmt.Sprintf("%s discrepancy in zone %s: %s", discrepancy.GetSeverity(), data.Fqdn.ValueString(), discrepancy.GetDescription()),
+		)
+	}
+}
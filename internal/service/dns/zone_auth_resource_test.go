@@ -3008,6 +3008,36 @@ func TestAccZoneAuthResource_View(t *testing.T) {
 	})
 }
 
+func TestAccZoneAuthResource_WarnOnDiscrepancies(t *testing.T) {
+	var resourceName = "nios_dns_zone_auth.test_warn_on_discrepancies"
+	var v dns.ZoneAuth
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneAuthWarnOnDiscrepancies(zoneFqdn, "default", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "warn_on_discrepancies", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneAuthWarnOnDiscrepancies(zoneFqdn, "default", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "warn_on_discrepancies", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccZoneAuthResource_ZoneFormatIPV4(t *testing.T) {
 	var resourceName = "nios_dns_zone_auth.test_zone_format"
 	var v dns.ZoneAuth
@@ -4111,6 +4141,16 @@ resource "nios_dns_zone_auth" "test_view" {
 `, zoneFqdn, view)
 }
 
+func testAccZoneAuthWarnOnDiscrepancies(zoneFqdn, view string, warnOnDiscrepancies bool) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_warn_on_discrepancies" {
+    fqdn = %q
+    view = %q
+    warn_on_discrepancies = %t
+}
+`, zoneFqdn, view, warnOnDiscrepancies)
+}
+
 func testAccZoneAuthZoneFormat(zoneFqdn, view, zoneFormat string) string {
 	return fmt.Sprintf(`
 resource "nios_dns_zone_auth" "test_zone_format" {
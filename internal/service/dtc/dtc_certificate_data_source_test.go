@@ -0,0 +1,58 @@
+package dtc_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDtcCertificateDataSource_All(t *testing.T) {
+	dataSourceName := "data.nios_dtc_certificate.test"
+	resourceName := "nios_dtc_certificate.test"
+	var v dtc.DtcCertificate
+	certificateFilePath := filepath.Join(getDtcCertificateTestDataPath(), "ca.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDtcCertificateDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcCertificateDataSourceConfigAll(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckDtcCertificateExists(context.Background(), resourceName, &v),
+					}, testAccCheckDtcCertificateResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+// DTC certificates cannot be searched by any field, so the uploaded certificate is looked up in all results.
+func testAccCheckDtcCertificateResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckTypeSetElemAttrPair(dataSourceName, "result.*.ref", resourceName, "ref"),
+		resource.TestCheckTypeSetElemAttrPair(dataSourceName, "result.*.certificate", resourceName, "certificate"),
+	}
+}
+
+func testAccDtcCertificateDataSourceConfigAll(certificateFilePath string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_certificate" "test" {
+  certificate_file_path = %q
+}
+
+data "nios_dtc_certificate" "test" {
+  depends_on = [nios_dtc_certificate.test]
+}
+`, certificateFilePath)
+}
@@ -0,0 +1,286 @@
+package dtc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DtcCertificateResource{}
+var _ resource.ResourceWithImportState = &DtcCertificateResource{}
+var _ resource.ResourceWithModifyPlan = &DtcCertificateResource{}
+
+func NewDtcCertificateResource() resource.Resource {
+	return &DtcCertificateResource{}
+}
+
+// DtcCertificateResource defines the resource implementation.
+type DtcCertificateResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *DtcCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dtc_certificate"
+}
+
+func (r *DtcCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a DTC certificate used by HTTPS health monitors, uploaded from PEM content or a local file.",
+		Attributes:          DtcCertificateResourceSchemaAttributes,
+	}
+}
+
+func (r *DtcCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan replaces the certificate when the configured file holds a different certificate than the uploaded one.
+func (r *DtcCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DtcCertificateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := plan.readCertificate()
+	if errors.Is(err, errFileNotAvailable) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate_file_path"), "Invalid Certificate", err.Error())
+		return
+	}
+	hash := sha256Hex(data)
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate_sha256"), types.StringValue(hash))...)
+		return
+	}
+
+	var state DtcCertificateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.CertificateSha256.IsNull() || state.CertificateSha256.ValueString() == hash {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate_sha256"), types.StringValue(hash))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ref"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("certificate_sha256"))
+}
+
+func (r *DtcCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DtcCertificateModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pemData, err := data.readCertificate()
+	if errors.Is(err, errFileNotAvailable) {
+		err = fmt.Errorf("certificate file %s does not exist", data.CertificateFilePath.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Certificate", err.Error())
+		return
+	}
+
+	// WAPI does not return the uploaded certificate, so the new one is found by comparing the certificates
+	// before and after the upload
+	before, err := r.listRefs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list DtcCertificate, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Uploading DTC certificate")
+	err = callDtcUploadFunctionWithData(ctx, r.client, "nios-dtc-certificate-*.pem", pemData, "add_certificate", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload DtcCertificate, got error: %s", err))
+		return
+	}
+
+	after, err := r.list(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list DtcCertificate, got error: %s", err))
+		return
+	}
+
+	var res *dtc.DtcCertificate
+	for i := range after {
+		if _, ok := before[after[i].GetRef()]; !ok {
+			res = &after[i]
+			break
+		}
+	}
+	if res == nil {
+		resp.Diagnostics.AddError(
+			"Resource Already Exists",
+			"The certificate was uploaded but no new DTC certificate was added, it probably exists already.\nPlease import the existing resource into terraform state.",
+		)
+		return
+	}
+
+	data.Flatten(ctx, res, &resp.Diagnostics)
+	data.CertificateSha256 = types.StringValue(sha256Hex(pemData))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DtcCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DtcCertificateModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *dtc.GetDtcCertificateResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.DTCAPI.
+			DtcCertificateAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForDtcCertificate).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcCertificate, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetDtcCertificateResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DtcCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DtcCertificateModel
+
+	// A DTC certificate cannot be changed in place, every change of the certificate replaces it
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DtcCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DtcCertificateModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The client does not model deleting DTC certificates, so the object is deleted with a WAPI request
+	err := utils.CallWAPIRequest(
+		ctx,
+		r.client.DTCAPI.Cfg.NIOSHostURL,
+		r.client.DTCAPI.Cfg.NIOSUsername,
+		r.client.DTCAPI.Cfg.NIOSPassword,
+		[]utils.WAPIRequest{{Method: http.MethodDelete, Object: utils.ExtractResourceRef(data.Ref.ValueString())}},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DtcCertificate, got error: %s", err))
+		return
+	}
+}
+
+func (r *DtcCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
+
+// list returns all DTC certificates.
+func (r *DtcCertificateResource) list(ctx context.Context) ([]dtc.DtcCertificate, error) {
+	apiRes, _, err := r.client.DTCAPI.
+		DtcCertificateAPI.
+		List(ctx).
+		ReturnFieldsPlus(readableAttributesForDtcCertificate).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	return apiRes.ListDtcCertificateResponseObject.GetResult(), nil
+}
+
+// listRefs returns the references of all DTC certificates.
+func (r *DtcCertificateResource) listRefs(ctx context.Context) (map[string]struct{}, error) {
+	certificates, err := r.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs := make(map[string]struct{}, len(certificates))
+	for _, certificate := range certificates {
+		refs[certificate.GetRef()] = struct{}{}
+	}
+	return refs, nil
+}
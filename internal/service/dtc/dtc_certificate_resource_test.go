@@ -0,0 +1,275 @@
+package dtc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDtcCertificate = "certificate,in_use"
+
+func TestAccDtcCertificateResource_basic(t *testing.T) {
+	var resourceName = "nios_dtc_certificate.test"
+	var v dtc.DtcCertificate
+	certificateFilePath := filepath.Join(getDtcCertificateTestDataPath(), "ca.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDtcCertificateDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDtcCertificateBasicConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_file_path", certificateFilePath),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_sha256"),
+					resource.TestCheckResourceAttr(resourceName, "in_use", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDtcCertificateResource_disappears(t *testing.T) {
+	resourceName := "nios_dtc_certificate.test"
+	var v dtc.DtcCertificate
+	certificateFilePath := filepath.Join(getDtcCertificateTestDataPath(), "ca.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDtcCertificateDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcCertificateBasicConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v),
+					testAccCheckDtcCertificateDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDtcCertificateResource_CertificatePem(t *testing.T) {
+	var resourceName = "nios_dtc_certificate.test_certificate_pem"
+	var v dtc.DtcCertificate
+	certificate, err := os.ReadFile(filepath.Join(getDtcCertificateTestDataPath(), "ca.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificateUpdated, err := os.ReadFile(filepath.Join(getDtcCertificateTestDataPath(), "ca_updated.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDtcCertificateCertificatePem(string(certificate)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_pem", string(certificate)),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDtcCertificateCertificatePem(string(certificateUpdated)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_pem", string(certificateUpdated)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDtcCertificateResource_CertificateFilePath(t *testing.T) {
+	var resourceName = "nios_dtc_certificate.test"
+	var v dtc.DtcCertificate
+	var ref string
+	certificateFilePath := filepath.Join(t.TempDir(), "ca.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				PreConfig: func() { copyDtcCertificateTestFile(t, "ca.pem", certificateFilePath) },
+				Config:    testAccDtcCertificateBasicConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v),
+					func(s *terraform.State) error {
+						ref = v.GetRef()
+						return nil
+					},
+				),
+			},
+			// Replace the certificate when the file holds a renewed certificate
+			{
+				PreConfig: func() { copyDtcCertificateTestFile(t, "ca_updated.pem", certificateFilePath) },
+				Config:    testAccDtcCertificateBasicConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v),
+					func(s *terraform.State) error {
+						if v.GetRef() == ref {
+							return fmt.Errorf("expected the certificate to be replaced, ref is still %s", ref)
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDtcCertificateResource_Import(t *testing.T) {
+	var resourceName = "nios_dtc_certificate.test"
+	var v dtc.DtcCertificate
+	certificateFilePath := filepath.Join(getDtcCertificateTestDataPath(), "ca.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcCertificateBasicConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccDtcCertificateImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"certificate_file_path", "certificate_sha256"},
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func testAccCheckDtcCertificateExists(ctx context.Context, resourceName string, v *dtc.DtcCertificate) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DTCAPI.
+			DtcCertificateAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForDtcCertificate).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetDtcCertificateResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetDtcCertificateResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckDtcCertificateDestroy(ctx context.Context, v *dtc.DtcCertificate) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DTCAPI.
+			DtcCertificateAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForDtcCertificate).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckDtcCertificateDisappears(ctx context.Context, v *dtc.DtcCertificate) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		return utils.CallWAPIRequest(
+			ctx,
+			acctest.NIOSClient.DTCAPI.Cfg.NIOSHostURL,
+			acctest.NIOSClient.DTCAPI.Cfg.NIOSUsername,
+			acctest.NIOSClient.DTCAPI.Cfg.NIOSPassword,
+			[]utils.WAPIRequest{{Method: http.MethodDelete, Object: utils.ExtractResourceRef(*v.Ref)}},
+		)
+	}
+}
+
+func testAccDtcCertificateImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccDtcCertificateBasicConfig(certificateFilePath string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_certificate" "test" {
+    certificate_file_path = %q
+}
+`, certificateFilePath)
+}
+
+func testAccDtcCertificateCertificatePem(certificate string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_certificate" "test_certificate_pem" {
+    certificate_pem = %q
+}
+`, certificate)
+}
+
+func getDtcCertificateTestDataPath() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "../../testdata/nios_dtc_certificate"
+	}
+	return filepath.Join(wd, "../../testdata/nios_dtc_certificate")
+}
+
+func copyDtcCertificateTestFile(t *testing.T, name, dest string) {
+	data, err := os.ReadFile(filepath.Join(getDtcCertificateTestDataPath(), name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dest, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,60 @@
+package dtc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+)
+
+const (
+	// DtcHealthTimeout is the default amount of time to wait for a DTC object to become healthy, in seconds
+	DtcHealthTimeout = 600
+
+	// dtcAvailabilityGreen is the availability of a DTC object whose monitors report it as up
+	dtcAvailabilityGreen = "GREEN"
+)
+
+var errDtcHealthPending = errors.New("DTC object is not healthy yet")
+
+// dtcHealthTimeout returns the configured wait_for_health_timeout, or the default if it is not set.
+func dtcHealthTimeout(timeout types.Int64) time.Duration {
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return DtcHealthTimeout * time.Second
+	}
+	return time.Duration(timeout.ValueInt64()) * time.Second
+}
+
+// waitForDtcHealth polls the health of a DTC object until its availability is GREEN or the timeout expires.
+// readHealth returns the availability and the description of the current health of the object.
+func waitForDtcHealth(ctx context.Context, objectName string, timeout time.Duration, readHealth func(ctx context.Context) (string, string, error)) error {
+	var availability, description string
+
+	err := retry.DoWithTimeout(ctx, timeout, isDtcHealthPending, func(ctx context.Context) (int, error) {
+		var err error
+		availability, description, err = readHealth(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if availability != dtcAvailabilityGreen {
+			tflog.Debug(ctx, fmt.Sprintf("Waiting for %s to become healthy, availability is %s", objectName, availability))
+			return 0, errDtcHealthPending
+		}
+		return 0, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s did not become healthy within %s, last availability %q: %s (%w)", objectName, timeout, availability, description, err)
+	}
+	return nil
+}
+
+// isDtcHealthPending checks if the error indicates that the DTC object is not healthy yet.
+func isDtcHealthPending(err error) bool {
+	return errors.Is(err, errDtcHealthPending) || retry.TransientErrors(err)
+}
@@ -131,6 +131,14 @@ func (r *DtcPoolResource) Create(ctx context.Context, req resource.CreateRequest
 
 	data.Flatten(ctx, &res, &resp.Diagnostics)
 
+	if data.WaitForHealth.ValueBool() {
+		// The DTC Pool exists at this point, so it is saved even if it does not become healthy and Terraform marks it as tainted
+		err = waitForDtcHealth(ctx, fmt.Sprintf("DTC Pool %s", data.Name.ValueString()), dtcHealthTimeout(data.WaitForHealthTimeout), r.readHealth(&data))
+		if err != nil {
+			resp.Diagnostics.AddError("DTC Pool Not Healthy", err.Error())
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -435,3 +443,26 @@ func (r *DtcPoolResource) ValidateConfig(ctx context.Context, req resource.Valid
 		}
 	}
 }
+
+// readHealth returns a function that reads the health of the DTC Pool and stores it in data.
+func (r *DtcPoolResource) readHealth(data *DtcPoolModel) func(ctx context.Context) (string, string, error) {
+	return func(ctx context.Context) (string, string, error) {
+		apiRes, _, err := r.client.DTCAPI.
+			DtcPoolAPI.
+			Read(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+			ReturnFields("health").
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+		if err != nil {
+			return "", "", err
+		}
+		res := apiRes.GetDtcPoolResponseObjectAsResult.GetResult()
+		var diags diag.Diagnostics
+		data.Health = FlattenDtcPoolHealth(ctx, res.Health, &diags)
+		if diags.HasError() {
+			return "", "", fmt.Errorf("unable to read health of DTC Pool %s", data.Name.ValueString())
+		}
+		return res.Health.GetAvailability(), res.Health.GetDescription(), nil
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -896,6 +897,30 @@ func TestAccDtcPoolResource_UseTtl(t *testing.T) {
 	})
 }
 
+func TestAccDtcPoolResource_WaitForHealth(t *testing.T) {
+	name := acctest.RandomNameWithPrefix("dtc-pool")
+	lbPreferredMethod := "ROUND_ROBIN"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create fails as the only server of the pool does not become healthy within the timeout
+			{
+				Config:      testAccDtcPoolWaitForHealth(name, lbPreferredMethod, 30),
+				ExpectError: regexp.MustCompile(`DTC Pool Not Healthy`),
+			},
+			// The pool was created and is replaced as it is tainted
+			{
+				Config:             testAccDtcPoolWaitForHealth(name, lbPreferredMethod, 30),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccCheckDtcPoolExists(ctx context.Context, resourceName string, v *dtc.DtcPool) resource.TestCheckFunc {
 	// Verify the resource exists in the cloud
 	return func(state *terraform.State) error {
@@ -1271,6 +1296,31 @@ resource "nios_dtc_pool" "test_use_ttl" {
 `, useTtl, name, lbPreferredMethod, ttl)
 }
 
+func testAccDtcPoolWaitForHealth(name, lbPreferredMethod string, timeout int) string {
+	// TEST-NET-1 address, the ICMP monitor never reports it as up
+	config := fmt.Sprintf(`
+resource "nios_dtc_server" "test_server1" {
+	name = %q
+	host = "192.0.2.11"
+}
+
+resource "nios_dtc_pool" "test_wait_for_health" {
+	name = %q
+	lb_preferred_method = %q
+	monitors = [nios_dtc_monitor_icmp.test_icmp_monitor1.ref]
+	servers = [
+		{
+			server = nios_dtc_server.test_server1.ref
+			ratio  = 100
+		}
+	]
+	wait_for_health = true
+	wait_for_health_timeout = %d
+}
+`, acctest.RandomNameWithPrefix("dtc-server"), name, lbPreferredMethod, timeout)
+	return strings.Join([]string{testAccBaseWithDtcMonitorIcmp(), config}, "")
+}
+
 func formatMonitorsToHCL(monitors []string) string {
 	monitorsList := make([]string, len(monitors))
 	for i, m := range monitors {
@@ -129,6 +129,14 @@ func (r *DtcServerResource) Create(ctx context.Context, req resource.CreateReque
 
 	data.Flatten(ctx, &res, &resp.Diagnostics)
 
+	if data.WaitForHealth.ValueBool() {
+		// The DTC Server exists at this point, so it is saved even if it does not become healthy and Terraform marks it as tainted
+		err = waitForDtcHealth(ctx, fmt.Sprintf("DTC Server %s", data.Name.ValueString()), dtcHealthTimeout(data.WaitForHealthTimeout), r.readHealth(&data))
+		if err != nil {
+			resp.Diagnostics.AddError("DTC Server Not Healthy", err.Error())
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -405,3 +413,26 @@ func (r *DtcServerResource) ImportState(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ref"), req.ID)...)
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "associate_internal_id", []byte("true"))...)
 }
+
+// readHealth returns a function that reads the health of the DTC Server and stores it in data.
+func (r *DtcServerResource) readHealth(data *DtcServerModel) func(ctx context.Context) (string, string, error) {
+	return func(ctx context.Context) (string, string, error) {
+		apiRes, _, err := r.client.DTCAPI.
+			DtcServerAPI.
+			Read(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+			ReturnFields("health").
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+		if err != nil {
+			return "", "", err
+		}
+		res := apiRes.GetDtcServerResponseObjectAsResult.GetResult()
+		var diags diag.Diagnostics
+		data.Health = FlattenDtcServerHealth(ctx, res.Health, &diags)
+		if diags.HasError() {
+			return "", "", fmt.Errorf("unable to read health of DTC Server %s", data.Name.ValueString())
+		}
+		return res.Health.GetAvailability(), res.Health.GetDescription(), nil
+	}
+}
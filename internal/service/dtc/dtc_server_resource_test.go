@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -395,6 +396,31 @@ func TestAccDtcServerResource_UseSniHostname(t *testing.T) {
 	})
 }
 
+func TestAccDtcServerResource_WaitForHealth(t *testing.T) {
+	name := acctest.RandomNameWithPrefix("dtc-server")
+	// TEST-NET-1 address, the ICMP monitor never reports it as up
+	host := "192.0.2.10"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create fails as the server does not become healthy within the timeout
+			{
+				Config:      testAccDtcServerWaitForHealth(name, host, 30),
+				ExpectError: regexp.MustCompile(`DTC Server Not Healthy`),
+			},
+			// The server was created and is replaced as it is tainted
+			{
+				Config:             testAccDtcServerWaitForHealth(name, host, 30),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccCheckDtcServerExists(ctx context.Context, resourceName string, v *dtc.DtcServer) resource.TestCheckFunc {
 	// Verify the resource exists in the cloud
 	return func(state *terraform.State) error {
@@ -560,6 +586,27 @@ resource "nios_dtc_server" "test_use_sni_hostname" {
 `, name, host, sniHostname, useSniHostname)
 }
 
+func testAccDtcServerWaitForHealth(name, host string, timeout int) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_monitor_icmp" "test_icmp_monitor" {
+	name = %q
+}
+
+resource "nios_dtc_server" "test_wait_for_health" {
+	name = %q
+	host = %q
+	monitors = [
+		{
+			monitor = nios_dtc_monitor_icmp.test_icmp_monitor.ref
+			host    = %q
+		}
+	]
+	wait_for_health = true
+	wait_for_health_timeout = %d
+}
+`, acctest.RandomNameWithPrefix("dtc-monitor-icmp"), name, host, host, timeout)
+}
+
 func formatMonitorsInterfaceToHCL(monitors []map[string]any) string {
 	var monitorBlocks []string
 
@@ -0,0 +1,353 @@
+package dtc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DtcStatusDataSource{}
+
+func NewDtcStatusDataSource() datasource.DataSource {
+	return &DtcStatusDataSource{}
+}
+
+// DtcStatusDataSource defines the data source implementation.
+type DtcStatusDataSource struct {
+	client *niosclient.APIClient
+}
+
+func (d *DtcStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dtc_status"
+}
+
+func (d *DtcStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	objectValidators := []validator.String{
+		stringvalidator.ExactlyOneOf(
+			path.MatchRoot("lbdn"),
+			path.MatchRoot("pool"),
+			path.MatchRoot("server"),
+		),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the live health of a DTC LBDN, Pool or Server as determined by its monitors, together with the health of its " +
+			"members, i.e. the pools of an LBDN or the servers of a pool.",
+		Attributes: map[string]schema.Attribute{
+			"lbdn": schema.StringAttribute{
+				Optional:            true,
+				Validators:          objectValidators,
+				MarkdownDescription: "The name of the DTC LBDN to retrieve the status of.",
+			},
+			"pool": schema.StringAttribute{
+				Optional:            true,
+				Validators:          objectValidators,
+				MarkdownDescription: "The name of the DTC Pool to retrieve the status of.",
+			},
+			"server": schema.StringAttribute{
+				Optional:            true,
+				Validators:          objectValidators,
+				MarkdownDescription: "The name of the DTC Server to retrieve the status of.",
+			},
+			"ref": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The reference to the DTC object.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the DTC object, `LBDN`, `POOL` or `SERVER`.",
+			},
+			"availability": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The availability color status of the DTC object, e.g. `GREEN`, `YELLOW`, `RED`, `BLUE` or `GRAY`.",
+			},
+			"enabled_state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The enabled state of the DTC object.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The textual description of the status of the DTC object, including the reason it is not available.",
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the availability of the DTC object is `GREEN`.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The availability color status of the DTC object across the Grid.",
+			},
+			"status_time": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the status of the DTC object was last determined.",
+			},
+			"members": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ref": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The reference to the member.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the member.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the member, `POOL` or `SERVER`.",
+						},
+						"availability": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The availability color status of the member.",
+						},
+						"enabled_state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The enabled state of the member.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The textual description of the status of the member, including the reason it is not available.",
+						},
+						"healthy": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the availability of the member is `GREEN`.",
+						},
+					},
+				},
+				MarkdownDescription: "The status of the pools of an LBDN or the servers of a pool. Empty for a server.",
+			},
+		},
+	}
+}
+
+func (d *DtcStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DtcStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DtcStatusModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		name    string
+		members []DtcStatusMemberModel
+	)
+
+	switch {
+	case !data.Lbdn.IsNull():
+		name = data.Lbdn.ValueString()
+		members = d.readLbdn(ctx, &data, &resp.Diagnostics)
+	case !data.Pool.IsNull():
+		name = data.Pool.ValueString()
+		members = d.readPool(ctx, &data, &resp.Diagnostics)
+	default:
+		name = data.Server.ValueString()
+		d.readServer(ctx, &data, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.readObjectStatus(ctx, &data, name, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if members == nil {
+		members = []DtcStatusMemberModel{}
+	}
+	membersList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: DtcStatusMemberAttrTypes}, members)
+	resp.Diagnostics.Append(diags...)
+	data.Members = membersList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readLbdn sets the health of the LBDN and returns the health of its pools.
+func (d *DtcStatusDataSource) readLbdn(ctx context.Context, data *DtcStatusModel, diags *diag.Diagnostics) []DtcStatusMemberModel {
+	apiRes, _, err := d.client.DTCAPI.
+		DtcLbdnAPI.
+		List(ctx).
+		Filters(map[string]any{"name": data.Lbdn.ValueString()}).
+		ReturnFields("name,health,pools").
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read DtcLbdn %s, got error: %s", data.Lbdn.ValueString(), err))
+		return nil
+	}
+	list := apiRes.ListDtcLbdnResponseObject.GetResult()
+	if len(list) == 0 {
+		diags.AddError("Not Found", fmt.Sprintf("No DtcLbdn named %s exists", data.Lbdn.ValueString()))
+		return nil
+	}
+
+	lbdn := list[0]
+	health := lbdn.GetHealth()
+	data.Ref = flex.FlattenStringPointer(lbdn.Ref)
+	data.Type = types.StringValue("LBDN")
+	data.flattenHealth(&health)
+
+	var members []DtcStatusMemberModel
+	for _, pool := range lbdn.Pools {
+		ref := pool.GetPool()
+		poolRes, _, err := d.client.DTCAPI.
+			DtcPoolAPI.
+			Read(ctx, utils.ExtractResourceRef(ref)).
+			ReturnFields("name,health").
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read DtcPool %s, got error: %s", ref, err))
+			return nil
+		}
+		res := poolRes.GetDtcPoolResponseObjectAsResult.GetResult()
+		poolHealth := res.GetHealth()
+		members = append(members, newDtcStatusMember(ref, res.GetName(), "POOL", &poolHealth))
+	}
+	return members
+}
+
+// readPool sets the health of the pool and returns the health of its servers.
+func (d *DtcStatusDataSource) readPool(ctx context.Context, data *DtcStatusModel, diags *diag.Diagnostics) []DtcStatusMemberModel {
+	apiRes, _, err := d.client.DTCAPI.
+		DtcPoolAPI.
+		List(ctx).
+		Filters(map[string]any{"name": data.Pool.ValueString()}).
+		ReturnFields("name,health,servers").
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read DtcPool %s, got error: %s", data.Pool.ValueString(), err))
+		return nil
+	}
+	list := apiRes.ListDtcPoolResponseObject.GetResult()
+	if len(list) == 0 {
+		diags.AddError("Not Found", fmt.Sprintf("No DtcPool named %s exists", data.Pool.ValueString()))
+		return nil
+	}
+
+	pool := list[0]
+	health := pool.GetHealth()
+	data.Ref = flex.FlattenStringPointer(pool.Ref)
+	data.Type = types.StringValue("POOL")
+	data.flattenHealth(&health)
+
+	var members []DtcStatusMemberModel
+	for _, server := range pool.Servers {
+		ref := server.GetServer()
+		serverRes, _, err := d.client.DTCAPI.
+			DtcServerAPI.
+			Read(ctx, utils.ExtractResourceRef(ref)).
+			ReturnFields("name,health").
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read DtcServer %s, got error: %s", ref, err))
+			return nil
+		}
+		res := serverRes.GetDtcServerResponseObjectAsResult.GetResult()
+		serverHealth := res.GetHealth()
+		members = append(members, newDtcStatusMember(ref, res.GetName(), "SERVER", &serverHealth))
+	}
+	return members
+}
+
+// readServer sets the health of the server.
+func (d *DtcStatusDataSource) readServer(ctx context.Context, data *DtcStatusModel, diags *diag.Diagnostics) {
+	apiRes, _, err := d.client.DTCAPI.
+		DtcServerAPI.
+		List(ctx).
+		Filters(map[string]any{"name": data.Server.ValueString()}).
+		ReturnFields("name,health").
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read DtcServer %s, got error: %s", data.Server.ValueString(), err))
+		return
+	}
+	list := apiRes.ListDtcServerResponseObject.GetResult()
+	if len(list) == 0 {
+		diags.AddError("Not Found", fmt.Sprintf("No DtcServer named %s exists", data.Server.ValueString()))
+		return
+	}
+
+	server := list[0]
+	health := server.GetHealth()
+	data.Ref = flex.FlattenStringPointer(server.Ref)
+	data.Type = types.StringValue("SERVER")
+	data.flattenHealth(&health)
+}
+
+// readObjectStatus sets the Grid wide status of the DTC object from the dtc:object status API.
+func (d *DtcStatusDataSource) readObjectStatus(ctx context.Context, data *DtcStatusModel, name string, diags *diag.Diagnostics) {
+	apiRes, _, err := d.client.DTCAPI.
+		DtcObjectAPI.
+		List(ctx).
+		Filters(map[string]any{"name": name}).
+		ReturnFields("object,status,status_time").
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read status of DTC object %s, got error: %s", name, err))
+		return
+	}
+
+	data.Status = types.StringNull()
+	data.StatusTime = types.Int64Null()
+
+	// Objects of different types can have the same name, the object reference identifies the one that was read
+	objectType := strings.SplitN(data.Ref.ValueString(), "/", 2)[0]
+	for _, object := range apiRes.ListDtcObjectResponseObject.GetResult() {
+		if strings.SplitN(object.GetObject(), "/", 2)[0] == objectType {
+			data.Status = flex.FlattenStringPointer(object.Status)
+			data.StatusTime = flex.FlattenInt64Pointer(object.StatusTime)
+			return
+		}
+	}
+}
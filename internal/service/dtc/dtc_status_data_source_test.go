@@ -0,0 +1,119 @@
+package dtc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDtcStatusDataSource_Pool(t *testing.T) {
+	dataSourceName := "data.nios_dtc_status.test"
+	poolName := acctest.RandomNameWithPrefix("dtc-pool")
+	serverName := acctest.RandomNameWithPrefix("dtc-server")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcStatusDataSourceConfigPool(poolName, serverName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "ref", "nios_dtc_pool.test", "ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "POOL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "availability"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "healthy"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "members.0.ref", "nios_dtc_server.test", "ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.name", serverName),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.type", "SERVER"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "members.0.availability"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDtcStatusDataSource_Server(t *testing.T) {
+	dataSourceName := "data.nios_dtc_status.test"
+	serverName := acctest.RandomNameWithPrefix("dtc-server")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcStatusDataSourceConfigServer(serverName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "ref", "nios_dtc_server.test", "ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "SERVER"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "availability", "nios_dtc_server.test", "health.availability"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDtcStatusDataSource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDtcStatusDataSourceConfigLbdn(acctest.RandomNameWithPrefix("dtc-lbdn")),
+				ExpectError: regexp.MustCompile(`No DtcLbdn named`),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccDtcStatusDataSourceConfigPool(poolName, serverName string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_server" "test" {
+	name = %q
+	host = %q
+}
+
+resource "nios_dtc_pool" "test" {
+	name = %q
+	lb_preferred_method = "ROUND_ROBIN"
+	servers = [
+		{
+			server = nios_dtc_server.test.ref
+			ratio  = 100
+		}
+	]
+}
+
+data "nios_dtc_status" "test" {
+	pool = nios_dtc_pool.test.name
+}
+`, serverName, acctest.RandomIP(), poolName)
+}
+
+func testAccDtcStatusDataSourceConfigServer(serverName string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_server" "test" {
+	name = %q
+	host = %q
+}
+
+data "nios_dtc_status" "test" {
+	server = nios_dtc_server.test.name
+}
+`, serverName, acctest.RandomIP())
+}
+
+func testAccDtcStatusDataSourceConfigLbdn(lbdnName string) string {
+	return fmt.Sprintf(`
+data "nios_dtc_status" "test" {
+	lbdn = %q
+}
+`, lbdnName)
+}
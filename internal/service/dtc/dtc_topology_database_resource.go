@@ -0,0 +1,281 @@
+package dtc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+)
+
+var readableAttributesForDtcTopologyDatabase = "binary_major_version,binary_minor_version,build_time,database_type,deployment_time,member,topology_type"
+
+var errDtcTopologyDatabasePending = errors.New("topology database is not deployed yet")
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DtcTopologyDatabaseResource{}
+var _ resource.ResourceWithImportState = &DtcTopologyDatabaseResource{}
+var _ resource.ResourceWithModifyPlan = &DtcTopologyDatabaseResource{}
+
+func NewDtcTopologyDatabaseResource() resource.Resource {
+	return &DtcTopologyDatabaseResource{}
+}
+
+// DtcTopologyDatabaseResource defines the resource implementation.
+type DtcTopologyDatabaseResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *DtcTopologyDatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dtc_topology_database"
+}
+
+func (r *DtcTopologyDatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a custom MaxMind GeoIP or EA Topology database that DTC topology rules match on. " +
+			"The labels of the database are available with the `nios_dtc_topology_label` data source. " +
+			"Destroying the resource only removes it from the Terraform state, the deployed database stays in use.",
+		Attributes: DtcTopologyDatabaseResourceSchemaAttributes,
+	}
+}
+
+func (r *DtcTopologyDatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan uploads the database again when the content of the configured file changes.
+func (r *DtcTopologyDatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DtcTopologyDatabaseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := readLocalFile(plan.FilePath)
+	if errors.Is(err, errFileNotAvailable) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Invalid Topology Database", err.Error())
+		return
+	}
+	hash := sha256Hex(data)
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_sha256"), types.StringValue(hash))...)
+		return
+	}
+
+	var state DtcTopologyDatabaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.FileSha256.ValueString() == hash {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_sha256"), types.StringValue(hash))...)
+	for attr, value := range plan.computedAttributes() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), value)...)
+	}
+}
+
+func (r *DtcTopologyDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DtcTopologyDatabaseModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.upload(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DtcTopologyDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DtcTopologyDatabaseModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.readInfo(ctx, data.TopologyType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read GridMaxminddbinfo, got error: %s", err))
+		return
+	}
+	if res == nil {
+		// No database of the type is deployed anymore, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Flatten(ctx, res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DtcTopologyDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DtcTopologyDatabaseModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.FileSha256.IsUnknown() || data.FileSha256.ValueString() != state.FileSha256.ValueString() {
+		r.upload(ctx, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		data.Ref = state.Ref
+		data.DatabaseType = state.DatabaseType
+		data.BuildTime = state.BuildTime
+		data.DeploymentTime = state.DeploymentTime
+		data.BinaryMajorVersion = state.BinaryMajorVersion
+		data.BinaryMinorVersion = state.BinaryMinorVersion
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DtcTopologyDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A deployed Topology database cannot be removed, it is only replaced by the next upload
+	tflog.Info(ctx, "Removing DTC Topology database from state, the deployed database stays in use")
+}
+
+func (r *DtcTopologyDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("topology_type"), req, resp)
+}
+
+// upload uploads the configured database file and waits until the Grid reports a new deployment of the database.
+func (r *DtcTopologyDatabaseResource) upload(ctx context.Context, data *DtcTopologyDatabaseModel, diags *diag.Diagnostics) {
+	filePath := data.FilePath.ValueString()
+	topologyType := data.TopologyType.ValueString()
+
+	content, err := readLocalFile(data.FilePath)
+	if errors.Is(err, errFileNotAvailable) {
+		err = fmt.Errorf("topology database file %s does not exist", filePath)
+	}
+	if err != nil {
+		diags.AddError("Invalid Topology Database", err.Error())
+		return
+	}
+
+	previous, err := r.readInfo(ctx, topologyType)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read GridMaxminddbinfo, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Uploading DTC Topology database", map[string]any{"file_path": filePath, "topology_type": topologyType})
+	err = callDtcUploadFunction(ctx, r.client, filePath, "import_maxminddb", map[string]any{"topology_type": topologyType})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to upload Topology database %s, got error: %s", filePath, err))
+		return
+	}
+
+	timeout := time.Duration(DtcTopologyDatabaseTimeout) * time.Second
+	if !data.WaitTimeout.IsNull() {
+		timeout = time.Duration(data.WaitTimeout.ValueInt64()) * time.Second
+	}
+
+	var res *grid.GridMaxminddbinfo
+	err = retry.DoWithTimeout(ctx, timeout, isDtcTopologyDatabasePending, func(ctx context.Context) (int, error) {
+		var callErr error
+		res, callErr = r.readInfo(ctx, topologyType)
+		if callErr != nil {
+			return 0, callErr
+		}
+		if res == nil || (previous != nil && res.GetDeploymentTime() == previous.GetDeploymentTime()) {
+			return 0, errDtcTopologyDatabasePending
+		}
+		return 0, nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Topology Database Not Deployed",
+			fmt.Sprintf("Topology database %s was not deployed within %s, got error: %s", filePath, timeout, err),
+		)
+		return
+	}
+
+	data.Flatten(ctx, res, diags)
+	data.FileSha256 = types.StringValue(sha256Hex(content))
+}
+
+// readInfo returns the information about the deployed database of the given type, or nil if none is deployed.
+// The information of the Grid is preferred over the information reported by a member.
+func (r *DtcTopologyDatabaseResource) readInfo(ctx context.Context, topologyType string) (*grid.GridMaxminddbinfo, error) {
+	apiRes, _, err := r.client.GridAPI.
+		GridMaxminddbinfoAPI.
+		List(ctx).
+		Filters(map[string]interface{}{"topology_type": topologyType}).
+		ReturnFieldsPlus(readableAttributesForDtcTopologyDatabase).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	var res *grid.GridMaxminddbinfo
+	for _, info := range apiRes.ListGridMaxminddbinfoResponseObject.GetResult() {
+		if info.GetMember() == "" {
+			return &info, nil
+		}
+		if res == nil {
+			res = &info
+		}
+	}
+	return res, nil
+}
+
+// isDtcTopologyDatabasePending checks if the error indicates that the uploaded database is not deployed yet.
+func isDtcTopologyDatabasePending(err error) bool {
+	return errors.Is(err, errDtcTopologyDatabasePending) || retry.TransientErrors(err)
+}
@@ -0,0 +1,137 @@
+package dtc_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDtcTopologyDatabaseResource_basic(t *testing.T) {
+	var resourceName = "nios_dtc_topology_database.test"
+	databaseFilePath := getDtcTopologyDatabaseTestFile(t, "GeoLite2-Country.mmdb")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDtcTopologyDatabaseBasicConfig("GEOIP", databaseFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "topology_type", "GEOIP"),
+					resource.TestCheckResourceAttr(resourceName, "file_path", databaseFilePath),
+					resource.TestCheckResourceAttrSet(resourceName, "file_sha256"),
+					resource.TestCheckResourceAttr(resourceName, "database_type", "GeoLite2-Country"),
+					resource.TestCheckResourceAttrSet(resourceName, "build_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_time"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDtcTopologyDatabaseResource_FilePath(t *testing.T) {
+	var resourceName = "nios_dtc_topology_database.test"
+	var deploymentTime string
+	databaseFilePath := filepath.Join(t.TempDir(), "topology.mmdb")
+	country := getDtcTopologyDatabaseTestFile(t, "GeoLite2-Country.mmdb")
+	city := getDtcTopologyDatabaseTestFile(t, "GeoLite2-City.mmdb")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				PreConfig: func() { copyDtcTopologyDatabaseTestFile(t, country, databaseFilePath) },
+				Config:    testAccDtcTopologyDatabaseBasicConfig("GEOIP", databaseFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "database_type", "GeoLite2-Country"),
+					resource.TestCheckResourceAttrWith(resourceName, "deployment_time", func(value string) error {
+						deploymentTime = value
+						return nil
+					}),
+				),
+			},
+			// Upload the database again when the content of the file changes
+			{
+				PreConfig: func() { copyDtcTopologyDatabaseTestFile(t, city, databaseFilePath) },
+				Config:    testAccDtcTopologyDatabaseBasicConfig("GEOIP", databaseFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "database_type", "GeoLite2-City"),
+					resource.TestCheckResourceAttrWith(resourceName, "deployment_time", func(value string) error {
+						if value == deploymentTime {
+							return fmt.Errorf("expected the database to be deployed again, deployment_time is still %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDtcTopologyDatabaseResource_Import(t *testing.T) {
+	var resourceName = "nios_dtc_topology_database.test"
+	databaseFilePath := getDtcTopologyDatabaseTestFile(t, "GeoLite2-Country.mmdb")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcTopologyDatabaseBasicConfig("GEOIP", databaseFilePath),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        "GEOIP",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"file_path", "file_sha256"},
+				ImportStateVerifyIdentifierAttribute: "topology_type",
+			},
+		},
+	})
+}
+
+func testAccDtcTopologyDatabaseBasicConfig(topologyType, filePath string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_topology_database" "test" {
+    topology_type = %q
+    file_path     = %q
+}
+`, topologyType, filePath)
+}
+
+// getDtcTopologyDatabaseTestFile returns the path of a MaxMind database in the test data. The databases
+// cannot be redistributed, so the test is skipped when they are not downloaded.
+func getDtcTopologyDatabaseTestFile(t *testing.T, name string) string {
+	dataPath := "../../testdata/nios_dtc_topology_database"
+	if wd, err := os.Getwd(); err == nil {
+		dataPath = filepath.Join(wd, dataPath)
+	}
+	filePath := filepath.Join(dataPath, name)
+	if _, err := os.Stat(filePath); err != nil {
+		t.Skipf("Skipping test: MaxMind database %s must be downloaded to %s", name, dataPath)
+	}
+	return filePath
+}
+
+func copyDtcTopologyDatabaseTestFile(t *testing.T, src, dest string) {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dest, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
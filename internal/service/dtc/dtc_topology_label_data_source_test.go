@@ -0,0 +1,43 @@
+package dtc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDtcTopologyLabelDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dtc_topology_label.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcTopologyLabelDataSourceConfigFilters("Continent", "Europe"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.field", "Continent"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.label", "Europe"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccDtcTopologyLabelDataSourceConfigFilters(field, label string) string {
+	return fmt.Sprintf(`
+data "nios_dtc_topology_label" "test" {
+  filters = {
+	field = %q
+	label = %q
+  }
+}
+`, field, label)
+}
@@ -0,0 +1,50 @@
+package dtc
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// callDtcUploadFunction uploads the local file with the fileop flow and passes the upload token to the
+// given function of the DTC object.
+func callDtcUploadFunction(ctx context.Context, client *niosclient.APIClient, filePath, function string, args map[string]any) error {
+	baseUrl := client.DTCAPI.Cfg.NIOSHostURL
+	username := client.DTCAPI.Cfg.NIOSUsername
+	password := client.DTCAPI.Cfg.NIOSPassword
+
+	token, err := utils.UploadFileWithToken(ctx, baseUrl, filePath, username, password)
+	if err != nil {
+		return err
+	}
+
+	if args == nil {
+		args = map[string]any{}
+	}
+	args["token"] = token
+	_, err = utils.CallWAPIFunction(ctx, baseUrl, username, password, "dtc", function, args)
+	return err
+}
+
+// callDtcUploadFunctionWithData writes data to a temporary file and uploads it like callDtcUploadFunction.
+func callDtcUploadFunctionWithData(ctx context.Context, client *niosclient.APIClient, pattern string, data []byte, function string, args map[string]any) error {
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return fmt.Errorf("unable to create upload file: %w", err)
+	}
+	defer func() { _ = os.Remove(file.Name()) }()
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write upload file: %w", err)
+	}
+
+	return callDtcUploadFunction(ctx, client, file.Name(), function, args)
+}
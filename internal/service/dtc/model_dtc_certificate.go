@@ -0,0 +1,150 @@
+package dtc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+// errFileNotAvailable is returned when a configured file cannot be read yet, for example because
+// the file is created during the same apply.
+var errFileNotAvailable = errors.New("file is not available")
+
+type DtcCertificateModel struct {
+	Ref                 types.String `tfsdk:"ref"`
+	Certificate         types.String `tfsdk:"certificate"`
+	InUse               types.Bool   `tfsdk:"in_use"`
+	CertificatePem      types.String `tfsdk:"certificate_pem"`
+	CertificateFilePath types.String `tfsdk:"certificate_file_path"`
+	CertificateSha256   types.String `tfsdk:"certificate_sha256"`
+}
+
+var DtcCertificateAttrTypes = map[string]attr.Type{
+	"ref":                   types.StringType,
+	"certificate":           types.StringType,
+	"in_use":                types.BoolType,
+	"certificate_pem":       types.StringType,
+	"certificate_file_path": types.StringType,
+	"certificate_sha256":    types.StringType,
+}
+
+var DtcCertificateResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"certificate": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "Reference to underlying X509Certificate.",
+	},
+	"in_use": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines whether the certificate is in use or not.",
+	},
+	"certificate_pem": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.ExactlyOneOf(path.MatchRoot("certificate_pem"), path.MatchRoot("certificate_file_path")),
+		},
+		MarkdownDescription: "The PEM encoded certificate to upload. Exactly one of `certificate_pem` and `certificate_file_path` must be set.",
+	},
+	"certificate_file_path": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The local path of the PEM encoded certificate to upload. The certificate is replaced when the content of the file changes.",
+	},
+	"certificate_sha256": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The SHA-256 hash of the uploaded certificate.",
+	},
+}
+
+func FlattenDtcCertificate(ctx context.Context, from *dtc.DtcCertificate, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DtcCertificateAttrTypes)
+	}
+	m := DtcCertificateModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, DtcCertificateAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *DtcCertificateModel) Flatten(ctx context.Context, from *dtc.DtcCertificate, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = DtcCertificateModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Certificate = flex.FlattenStringPointer(from.Certificate)
+	m.InUse = types.BoolPointerValue(from.InUse)
+}
+
+// readCertificate returns the configured certificate, either the PEM content or the content of the local file.
+func (m *DtcCertificateModel) readCertificate() ([]byte, error) {
+	if m.CertificatePem.IsUnknown() || m.CertificateFilePath.IsUnknown() {
+		return nil, errFileNotAvailable
+	}
+	if !m.CertificatePem.IsNull() {
+		return []byte(m.CertificatePem.ValueString()), nil
+	}
+	return readLocalFile(m.CertificateFilePath)
+}
+
+// readLocalFile returns the content of the local file at filePath.
+func readLocalFile(filePath types.String) ([]byte, error) {
+	if filePath.IsNull() || filePath.IsUnknown() {
+		return nil, errFileNotAvailable
+	}
+	data, err := os.ReadFile(filePath.ValueString())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, errFileNotAvailable
+		}
+		return nil, fmt.Errorf("unable to read file %s: %w", filePath.ValueString(), err)
+	}
+	return data, nil
+}
+
+// sha256Hex returns the hex encoded SHA-256 hash of data.
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -48,6 +48,8 @@ type DtcPoolModel struct {
 	Servers                  types.List                       `tfsdk:"servers"`
 	Ttl                      types.Int64                      `tfsdk:"ttl"`
 	UseTtl                   types.Bool                       `tfsdk:"use_ttl"`
+	WaitForHealth            types.Bool                       `tfsdk:"wait_for_health"`
+	WaitForHealthTimeout     types.Int64                      `tfsdk:"wait_for_health_timeout"`
 }
 
 var DtcPoolAttrTypes = map[string]attr.Type{
@@ -72,6 +74,8 @@ var DtcPoolAttrTypes = map[string]attr.Type{
 	"servers":                    types.ListType{ElemType: types.ObjectType{AttrTypes: DtcPoolServersAttrTypes}},
 	"ttl":                        types.Int64Type,
 	"use_ttl":                    types.BoolType,
+	"wait_for_health":            types.BoolType,
+	"wait_for_health_timeout":    types.Int64Type,
 }
 
 var DtcPoolResourceSchemaAttributes = map[string]schema.Attribute{
@@ -222,6 +226,19 @@ var DtcPoolResourceSchemaAttributes = map[string]schema.Attribute{
 		Computed:            true,
 		Default:             booldefault.StaticBool(false),
 	},
+	"wait_for_health": schema.BoolAttribute{
+		Optional: true,
+		MarkdownDescription: "Flag to wait until the DTC Pool is healthy, i.e. its availability is GREEN, after it is created. " +
+			"The DTC Pool is saved but marked as tainted if it does not become healthy within `wait_for_health_timeout`.",
+	},
+	"wait_for_health_timeout": schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+			int64validator.AlsoRequires(path.MatchRoot("wait_for_health")),
+		},
+		MarkdownDescription: "The number of seconds to wait for the DTC Pool to become healthy. Defaults to 600 seconds.",
+	},
 }
 
 func (m *DtcPoolModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dtc.DtcPool {
@@ -3,6 +3,7 @@ package dtc
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -36,6 +37,8 @@ type DtcServerModel struct {
 	Name                 types.String `tfsdk:"name"`
 	SniHostname          types.String `tfsdk:"sni_hostname"`
 	UseSniHostname       types.Bool   `tfsdk:"use_sni_hostname"`
+	WaitForHealth        types.Bool   `tfsdk:"wait_for_health"`
+	WaitForHealthTimeout types.Int64  `tfsdk:"wait_for_health_timeout"`
 }
 
 var DtcServerAttrTypes = map[string]attr.Type{
@@ -51,6 +54,8 @@ var DtcServerAttrTypes = map[string]attr.Type{
 	"name":                    types.StringType,
 	"sni_hostname":            types.StringType,
 	"use_sni_hostname":        types.BoolType,
+	"wait_for_health":         types.BoolType,
+	"wait_for_health_timeout": types.Int64Type,
 }
 
 var DtcServerResourceSchemaAttributes = map[string]schema.Attribute{
@@ -137,6 +142,19 @@ var DtcServerResourceSchemaAttributes = map[string]schema.Attribute{
 		Default:             booldefault.StaticBool(false),
 		MarkdownDescription: "Use flag for: sni_hostname",
 	},
+	"wait_for_health": schema.BoolAttribute{
+		Optional: true,
+		MarkdownDescription: "Flag to wait until the DTC Server is healthy, i.e. its availability is GREEN, after it is created. " +
+			"The DTC Server is saved but marked as tainted if it does not become healthy within `wait_for_health_timeout`.",
+	},
+	"wait_for_health_timeout": schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+			int64validator.AlsoRequires(path.MatchRoot("wait_for_health")),
+		},
+		MarkdownDescription: "The number of seconds to wait for the DTC Server to become healthy. Defaults to 600 seconds.",
+	},
 }
 
 func (m *DtcServerModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dtc.DtcServer {
@@ -0,0 +1,79 @@
+package dtc
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type DtcStatusModel struct {
+	Lbdn         types.String `tfsdk:"lbdn"`
+	Pool         types.String `tfsdk:"pool"`
+	Server       types.String `tfsdk:"server"`
+	Ref          types.String `tfsdk:"ref"`
+	Type         types.String `tfsdk:"type"`
+	Availability types.String `tfsdk:"availability"`
+	EnabledState types.String `tfsdk:"enabled_state"`
+	Description  types.String `tfsdk:"description"`
+	Healthy      types.Bool   `tfsdk:"healthy"`
+	Status       types.String `tfsdk:"status"`
+	StatusTime   types.Int64  `tfsdk:"status_time"`
+	Members      types.List   `tfsdk:"members"`
+}
+
+type DtcStatusMemberModel struct {
+	Ref          types.String `tfsdk:"ref"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Availability types.String `tfsdk:"availability"`
+	EnabledState types.String `tfsdk:"enabled_state"`
+	Description  types.String `tfsdk:"description"`
+	Healthy      types.Bool   `tfsdk:"healthy"`
+}
+
+var DtcStatusMemberAttrTypes = map[string]attr.Type{
+	"ref":           types.StringType,
+	"name":          types.StringType,
+	"type":          types.StringType,
+	"availability":  types.StringType,
+	"enabled_state": types.StringType,
+	"description":   types.StringType,
+	"healthy":       types.BoolType,
+}
+
+// dtcStatusHealth is the health of a DTC LBDN, pool or server as returned by WAPI.
+type dtcStatusHealth interface {
+	GetAvailability() string
+	GetEnabledState() string
+	GetDescription() string
+}
+
+// flattenHealth sets the health attributes of the object.
+func (m *DtcStatusModel) flattenHealth(health dtcStatusHealth) {
+	m.Availability = flex.FlattenStringPointer(stringPointer(health.GetAvailability()))
+	m.EnabledState = flex.FlattenStringPointer(stringPointer(health.GetEnabledState()))
+	m.Description = flex.FlattenStringPointer(stringPointer(health.GetDescription()))
+	m.Healthy = types.BoolValue(health.GetAvailability() == dtcAvailabilityGreen)
+}
+
+// newDtcStatusMember returns the status of a member from its health.
+func newDtcStatusMember(ref, name, memberType string, health dtcStatusHealth) DtcStatusMemberModel {
+	return DtcStatusMemberModel{
+		Ref:          types.StringValue(ref),
+		Name:         types.StringValue(name),
+		Type:         types.StringValue(memberType),
+		Availability: flex.FlattenStringPointer(stringPointer(health.GetAvailability())),
+		EnabledState: flex.FlattenStringPointer(stringPointer(health.GetEnabledState())),
+		Description:  flex.FlattenStringPointer(stringPointer(health.GetDescription())),
+		Healthy:      types.BoolValue(health.GetAvailability() == dtcAvailabilityGreen),
+	}
+}
+
+// stringPointer returns nil for an empty string, so that missing values are stored as null.
+func stringPointer(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,123 @@
+package dtc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+const (
+	// DtcTopologyDatabaseTimeout is the default amount of time to wait for an uploaded database to be deployed, in seconds
+	DtcTopologyDatabaseTimeout = 300
+
+	dtcTopologyTypeGeoip = "GEOIP"
+	dtcTopologyTypeEa    = "EA"
+)
+
+type DtcTopologyDatabaseModel struct {
+	Ref                types.String `tfsdk:"ref"`
+	TopologyType       types.String `tfsdk:"topology_type"`
+	FilePath           types.String `tfsdk:"file_path"`
+	FileSha256         types.String `tfsdk:"file_sha256"`
+	WaitTimeout        types.Int64  `tfsdk:"wait_timeout"`
+	DatabaseType       types.String `tfsdk:"database_type"`
+	BuildTime          types.Int64  `tfsdk:"build_time"`
+	DeploymentTime     types.Int64  `tfsdk:"deployment_time"`
+	BinaryMajorVersion types.Int64  `tfsdk:"binary_major_version"`
+	BinaryMinorVersion types.Int64  `tfsdk:"binary_minor_version"`
+}
+
+var DtcTopologyDatabaseResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the Topology database information object.",
+	},
+	"topology_type": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.OneOf(dtcTopologyTypeGeoip, dtcTopologyTypeEa),
+		},
+		MarkdownDescription: "The type of the Topology database. `GEOIP` is a MaxMind GeoIP2 or GeoLite2 database, `EA` is a database of extensible attribute labels.",
+	},
+	"file_path": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The local path of the MaxMind DB formatted database to upload. The database is uploaded again when the content of the file changes.",
+	},
+	"file_sha256": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The SHA-256 hash of the uploaded database file.",
+	},
+	"wait_timeout": schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		MarkdownDescription: "The maximum time in seconds to wait for the uploaded database to be deployed. Defaults to 300.",
+	},
+	"database_type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The structure of the deployed database (e.g. `GeoLite2-Country` or `GeoLite2-City`).",
+	},
+	"build_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time at which the deployed database was built.",
+	},
+	"deployment_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time at which the current database was deployed.",
+	},
+	"binary_major_version": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The major version of the DB binary format.",
+	},
+	"binary_minor_version": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The minor version of the DB binary format.",
+	},
+}
+
+func (m *DtcTopologyDatabaseModel) Flatten(ctx context.Context, from *grid.GridMaxminddbinfo, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.TopologyType = flex.FlattenStringPointer(from.TopologyType)
+	m.DatabaseType = flex.FlattenStringPointer(from.DatabaseType)
+	m.BuildTime = flex.FlattenInt64Pointer(from.BuildTime)
+	m.DeploymentTime = flex.FlattenInt64Pointer(from.DeploymentTime)
+	m.BinaryMajorVersion = flex.FlattenInt64Pointer(from.BinaryMajorVersion)
+	m.BinaryMinorVersion = flex.FlattenInt64Pointer(from.BinaryMinorVersion)
+}
+
+// computedAttributes returns the attributes that are read from the deployed database.
+func (m *DtcTopologyDatabaseModel) computedAttributes() map[string]attr.Value {
+	return map[string]attr.Value{
+		"ref":                  types.StringUnknown(),
+		"database_type":        types.StringUnknown(),
+		"build_time":           types.Int64Unknown(),
+		"deployment_time":      types.Int64Unknown(),
+		"binary_major_version": types.Int64Unknown(),
+		"binary_minor_version": types.Int64Unknown(),
+	}
+}
@@ -0,0 +1,45 @@
+package federatedrealms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// Federated Realm : test-federated-realm
+
+func TestAccFederatedrealmsDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_federatedrealms.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFederatedrealmsDataSourceConfigFilters("test-federated-realm"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", "test-federated-realm"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.id"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccFederatedrealmsDataSourceConfigFilters(name string) string {
+	return fmt.Sprintf(`
+data "nios_federatedrealms" "test" {
+  filters = {
+    name = %q
+  }
+}
+`, name)
+}
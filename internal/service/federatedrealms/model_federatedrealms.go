@@ -0,0 +1,64 @@
+package federatedrealms
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/federatedrealms"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type FederatedrealmsModel struct {
+	Ref  types.String `tfsdk:"ref"`
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+var FederatedrealmsAttrTypes = map[string]attr.Type{
+	"ref":  types.StringType,
+	"id":   types.StringType,
+	"name": types.StringType,
+}
+
+var FederatedrealmsResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"id": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Federated realm id.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Federated realm name.",
+	},
+}
+
+func FlattenFederatedrealms(ctx context.Context, from *federatedrealms.Federatedrealms, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(FederatedrealmsAttrTypes)
+	}
+	m := FederatedrealmsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, FederatedrealmsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *FederatedrealmsModel) Flatten(ctx context.Context, from *federatedrealms.Federatedrealms, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = FederatedrealmsModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Id = flex.FlattenStringPointer(from.Id)
+	m.Name = flex.FlattenStringPointer(from.Name)
+}
@@ -0,0 +1,147 @@
+package grid
+
+import (
+	"testing"
+
+	gridclient "github.com/infobloxopen/infoblox-nios-go-client/grid"
+)
+
+func testMemberNode(statuses ...string) gridclient.MemberNodeInfo {
+	node := gridclient.MemberNodeInfo{}
+	for _, status := range statuses {
+		node.ServiceStatus = append(node.ServiceStatus, gridclient.MembernodeinfoServiceStatus{
+			Service:     gridclient.PtrString("NODE_STATUS"),
+			Status:      gridclient.PtrString(status),
+			Description: gridclient.PtrString(status + " description"),
+		})
+	}
+	return node
+}
+
+func TestMemberNodeStatus(t *testing.T) {
+	tests := []struct {
+		name            string
+		nodes           []gridclient.MemberNodeInfo
+		wantStatus      string
+		wantDescription string
+	}{
+		{"no nodes", nil, "", ""},
+		{"working", []gridclient.MemberNodeInfo{testMemberNode("WORKING")}, "WORKING", "WORKING description"},
+		{"offline", []gridclient.MemberNodeInfo{testMemberNode("OFFLINE")}, "OFFLINE", "OFFLINE description"},
+		{"ha passive node offline", []gridclient.MemberNodeInfo{testMemberNode("WORKING"), testMemberNode("OFFLINE")}, "OFFLINE", "OFFLINE description"},
+		{"ha nodes online", []gridclient.MemberNodeInfo{testMemberNode("WARNING"), testMemberNode("WORKING")}, "WARNING", "WARNING description"},
+		{
+			"other services ignored",
+			[]gridclient.MemberNodeInfo{{
+				ServiceStatus: []gridclient.MembernodeinfoServiceStatus{
+					{Service: gridclient.PtrString("DISK_USAGE"), Status: gridclient.PtrString("FAILED")},
+					{Service: gridclient.PtrString("NODE_STATUS"), Status: gridclient.PtrString("WORKING")},
+				},
+			}},
+			"WORKING", "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, description := memberNodeStatus(&gridclient.Member{NodeInfo: tt.nodes})
+			if status != tt.wantStatus || description != tt.wantDescription {
+				t.Errorf("memberNodeStatus() = %q, %q, expected %q, %q", status, description, tt.wantStatus, tt.wantDescription)
+			}
+		})
+	}
+}
+
+func TestIsMemberNodeStatusOnline(t *testing.T) {
+	for status, want := range map[string]bool{
+		"WORKING":  true,
+		"WARNING":  true,
+		"OFFLINE":  false,
+		"FAILED":   false,
+		"INACTIVE": false,
+		"":         false,
+	} {
+		if got := isMemberNodeStatusOnline(status); got != want {
+			t.Errorf("isMemberNodeStatusOnline(%q) = %t, expected %t", status, got, want)
+		}
+	}
+}
+
+func TestMemberURLHost(t *testing.T) {
+	tests := []struct {
+		memberURL string
+		want      string
+	}{
+		{"https://172.28.83.231", "172.28.83.231"},
+		{"https://172.28.83.231:443/", "172.28.83.231"},
+		{"https://[2600:1f1c:e86:5e01::30]:443", "2600:1f1c:e86:5e01::30"},
+		{"https://member.example.com", "member.example.com"},
+		{"172.28.83.231", "172.28.83.231"},
+	}
+
+	for _, tt := range tests {
+		if got := memberURLHost(tt.memberURL); got != tt.want {
+			t.Errorf("memberURLHost(%q) = %q, expected %q", tt.memberURL, got, tt.want)
+		}
+	}
+}
+
+func TestMemberHasAddress(t *testing.T) {
+	member := &gridclient.Member{
+		VipSetting:  &gridclient.MemberVipSetting{Address: gridclient.PtrString("172.28.82.231")},
+		Ipv6Setting: &gridclient.MemberIpv6Setting{VirtualIp: gridclient.PtrString("2600:1f1c:e86:5e01:0:0:0:30")},
+		NodeInfo: []gridclient.MemberNodeInfo{
+			{
+				LanHaPortSetting: &gridclient.MembernodeinfoLanHaPortSetting{
+					MgmtLan:      gridclient.PtrString("172.28.82.31"),
+					MgmtIpv6addr: gridclient.PtrString("2600:1f1c:e86:5e01::31"),
+				},
+			},
+			{
+				MgmtNetworkSetting: &gridclient.MembernodeinfoMgmtNetworkSetting{Address: gridclient.PtrString("10.0.0.32")},
+			},
+		},
+	}
+
+	tests := []struct {
+		address string
+		want    bool
+	}{
+		{"172.28.82.231", true},
+		{"2600:1f1c:e86:5e01::30", true},
+		{"172.28.82.31", true},
+		{"2600:1f1c:e86:5e01:0:0:0:31", true},
+		{"10.0.0.32", true},
+		{"172.28.82.232", false},
+		{"member.example.com", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := memberHasAddress(member, tt.address); got != tt.want {
+			t.Errorf("memberHasAddress(%q) = %t, expected %t", tt.address, got, tt.want)
+		}
+	}
+
+	if memberHasAddress(&gridclient.Member{}, "172.28.82.231") {
+		t.Error("memberHasAddress() matched a member without addresses")
+	}
+}
+
+func TestExtractRedirectURL(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`<HTML><META HTTP-EQUIV="REFRESH" CONTENT="0; URL=https://172.28.82.32/ui"></HTML>`, "https://172.28.82.32/ui"},
+		{`<HTML><META HTTP-EQUIV='REFRESH' CONTENT='0; URL= https://master.example.com '></HTML>`, "https://master.example.com"},
+		{`<HTML></HTML>`, ""},
+		{`URL=https://unterminated`, ""},
+	}
+
+	for _, tt := range tests {
+		if got := extractRedirectURL(tt.body); got != tt.want {
+			t.Errorf("extractRedirectURL(%q) = %q, expected %q", tt.body, got, tt.want)
+		}
+	}
+}
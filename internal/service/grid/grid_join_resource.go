@@ -3,10 +3,16 @@ package grid
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,8 +22,17 @@ import (
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	gridclient "github.com/infobloxopen/infoblox-nios-go-client/grid"
 	"github.com/infobloxopen/infoblox-nios-go-client/option"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
+var readableAttributesForGridJoinMember = "host_name,ipv6_setting,node_info,vip_setting"
+
+// errGridJoinPending is returned while the member is not yet online on the grid master
+var errGridJoinPending = errors.New("member has not joined the grid yet")
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GridJoinResource{}
 
@@ -36,7 +51,7 @@ func (r *GridJoinResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *GridJoinResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages joining a member to an Infoblox Grid.",
+		MarkdownDescription: "Manages joining a member to an Infoblox Grid. After the join is initiated, the grid master is polled until the member is online.",
 		Attributes:          GridJoinResourceSchemaAttributes,
 	}
 }
@@ -134,31 +149,311 @@ func (r *GridJoinResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Normal 200 response - grid join initiated
-	tflog.Debug(ctx, "Grid join initiated successfully. Please verify the grid status manually through the NIOS GUI to confirm the member has joined. If the join operation fails, manual intervention may be required to troubleshoot connectivity issues, credentials, or grid configuration.", map[string]any{
+	tflog.Info(ctx, "Grid join initiated, waiting for the member to come online on the grid master", map[string]any{
 		"member_url": data.MemberURL.ValueString(),
 		"master":     data.Master.ValueString(),
 		"grid_name":  data.GridName.ValueString(),
 	})
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	r.waitForMemberOnline(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GridJoinResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// Read does not perform any verification of the grid join status.
-	// The grid join operation is a one-time action.
+	var data GridJoinModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var member *gridclient.Member
+
+	if data.MemberRef.IsNull() || data.MemberRef.ValueString() == "" {
+		// State created before the member reference was tracked, locate the member instead
+		found, err := r.findMember(ctx, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read grid join member, got error: %s", err))
+			return
+		}
+		if found == nil {
+			tflog.Warn(ctx, "Unable to locate the joined member on the grid master, skipping verification", map[string]any{
+				"member_url": data.MemberURL.ValueString(),
+			})
+			return
+		}
+		member = found
+	} else {
+		var (
+			httpRes *http.Response
+			apiRes  *gridclient.GetMemberResponse
+		)
+
+		err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+			var callErr error
+			apiRes, httpRes, callErr = r.client.GridAPI.
+				MemberAPI.
+				Read(ctx, utils.ExtractResourceRef(data.MemberRef.ValueString())).
+				ReturnFields(readableAttributesForGridJoinMember).
+				ReturnAsObject(1).
+				ProxySearch(config.GetProxySearch()).
+				Execute()
+
+			if httpRes != nil {
+				return httpRes.StatusCode, callErr
+			}
+			return 0, callErr
+		})
+
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// Member has left the grid, remove from state so that the join is planned again
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read grid join member, got error: %s", err))
+			return
+		}
+
+		res := apiRes.GetMemberResponseObjectAsResult.GetResult()
+		member = &res
+	}
+
+	status, description := memberNodeStatus(member)
+	data.MemberRef = types.StringValue(member.GetRef())
+	data.MemberStatus = types.StringValue(status)
+
+	if !isMemberNodeStatusOnline(status) {
+		resp.Diagnostics.AddWarning(
+			"Grid Member Not Online",
+			fmt.Sprintf("Member %s is not online on the grid master, node status: %s %s", member.GetHostName(), status, description),
+		)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GridJoinResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Update is not supported as all grid join attributes are immutable.
+	var data GridJoinModel
+
+	// Join attributes are immutable, only the settings that control verification and destroy can change
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("member_ref"), &data.MemberRef)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("member_status"), &data.MemberStatus)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GridJoinResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Delete only removes the resource from Terraform state without making any API call.
-	// To actually unjoin a member from the grid, you must delete the corresponding nios_grid_member resource,
-	// which will remove the member from the grid and make it a standalone Grid.
-	// This resource does not directly support unjoining a member through deletion.
+	var data GridJoinModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unless leave_on_destroy is set, only remove the resource from Terraform state.
+	if !data.LeaveOnDestroy.ValueBool() {
+		return
+	}
+
+	memberRef := data.MemberRef.ValueString()
+	if memberRef == "" {
+		member, err := r.findMember(ctx, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to locate grid join member, got error: %s", err))
+			return
+		}
+		if member == nil {
+			return
+		}
+		memberRef = member.GetRef()
+	}
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		httpRes, callErr := r.client.GridAPI.
+			MemberAPI.
+			Delete(ctx, utils.ExtractResourceRef(memberRef)).
+			Execute()
+
+		if httpRes != nil {
+			if httpRes.StatusCode == http.StatusNotFound {
+				return 0, nil
+			}
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove member from the grid, got error: %s", err))
+		return
+	}
+}
+
+// waitForMemberOnline polls the grid master until the member reports a working node status or the join timeout expires.
+func (r *GridJoinResource) waitForMemberOnline(ctx context.Context, data *GridJoinModel, diags *diag.Diagnostics) {
+	var (
+		member              *gridclient.Member
+		status, description string
+	)
+
+	timeout := time.Duration(data.JoinTimeout.ValueInt64()) * time.Second
+
+	err := retry.DoWithTimeout(ctx, timeout, isGridJoinPending, func(ctx context.Context) (int, error) {
+		found, callErr := r.findMember(ctx, data)
+		if callErr != nil {
+			return 0, callErr
+		}
+		if found == nil {
+			return 0, errGridJoinPending
+		}
+		member = found
+		status, description = memberNodeStatus(member)
+		if !isMemberNodeStatusOnline(status) {
+			return 0, errGridJoinPending
+		}
+		return 0, nil
+	})
+
+	if err != nil {
+		switch {
+		case member == nil:
+			diags.AddError(
+				"Grid Join Failed",
+				fmt.Sprintf("Unable to find the member on the grid master within %s, got error: %s. Make sure the member is defined on the grid master, or set member_host_name.", timeout, err),
+			)
+		case status == "":
+			diags.AddError(
+				"Grid Join Failed",
+				fmt.Sprintf("Member %s did not report a node status within %s, got error: %s", member.GetHostName(), timeout, err),
+			)
+		default:
+			diags.AddError(
+				"Grid Join Failed",
+				fmt.Sprintf("Member %s did not come online within %s, last node status: %s %s", member.GetHostName(), timeout, status, description),
+			)
+		}
+		return
+	}
+
+	data.MemberRef = types.StringValue(member.GetRef())
+	data.MemberStatus = types.StringValue(status)
+}
+
+// findMember locates the joined member on the grid master by member_host_name, or by the address in member_url.
+// It returns nil if no matching member exists.
+func (r *GridJoinResource) findMember(ctx context.Context, data *GridJoinModel) (*gridclient.Member, error) {
+	request := r.client.GridAPI.
+		MemberAPI.
+		List(ctx).
+		ReturnFields(readableAttributesForGridJoinMember).
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch())
+
+	hostName := data.MemberHostName.ValueString()
+	if hostName != "" {
+		request = request.Filters(map[string]interface{}{
+			"host_name": hostName,
+		})
+	}
+
+	apiRes, _, err := request.Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	members := apiRes.ListMemberResponseObject.GetResult()
+	if hostName != "" {
+		if len(members) == 0 {
+			return nil, nil
+		}
+		return &members[0], nil
+	}
+
+	address := memberURLHost(data.MemberURL.ValueString())
+	for i := range members {
+		if memberHasAddress(&members[i], address) {
+			return &members[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// memberNodeStatus returns the NODE_STATUS of the member. For HA members the status of the first node
+// that is not online is returned, so the member is only considered online once all nodes are.
+func memberNodeStatus(member *gridclient.Member) (string, string) {
+	var status, description string
+	for _, node := range member.GetNodeInfo() {
+		for _, s := range node.GetServiceStatus() {
+			if s.GetService() != "NODE_STATUS" {
+				continue
+			}
+			if !isMemberNodeStatusOnline(s.GetStatus()) {
+				return s.GetStatus(), s.GetDescription()
+			}
+			if status == "" {
+				status, description = s.GetStatus(), s.GetDescription()
+			}
+		}
+	}
+	return status, description
+}
+
+func isMemberNodeStatusOnline(status string) bool {
+	return status == "WORKING" || status == "WARNING"
+}
+
+// isGridJoinPending checks if the error indicates that the member has not joined the grid yet.
+func isGridJoinPending(err error) bool {
+	return errors.Is(err, errGridJoinPending) || retry.TransientErrors(err)
+}
+
+func memberURLHost(memberURL string) string {
+	u, err := url.Parse(memberURL)
+	if err != nil || u.Hostname() == "" {
+		return memberURL
+	}
+	return u.Hostname()
+}
+
+func memberHasAddress(member *gridclient.Member, address string) bool {
+	addresses := []string{
+		member.VipSetting.GetAddress(),
+		member.Ipv6Setting.GetVirtualIp(),
+	}
+	for _, node := range member.GetNodeInfo() {
+		addresses = append(addresses,
+			node.LanHaPortSetting.GetMgmtLan(),
+			node.LanHaPortSetting.GetMgmtIpv6addr(),
+			node.MgmtNetworkSetting.GetAddress(),
+		)
+	}
+	for _, a := range addresses {
+		if a == "" {
+			continue
+		}
+		if a == address {
+			return true
+		}
+		if ip := net.ParseIP(a); ip != nil && ip.Equal(net.ParseIP(address)) {
+			return true
+		}
+	}
+	return false
 }
 
 func extractRedirectURL(body string) string {
@@ -0,0 +1,61 @@
+package grid_test
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO: OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// Member appliance that is not part of a grid, reachable at NIOS_JOIN_MEMBER_URL with the NIOS_USERNAME and NIOS_PASSWORD credentials
+
+func TestAccGridJoinResource_JoinTimeout(t *testing.T) {
+	memberURL := utils.GetNIOSJoinMemberURL()
+	if memberURL == "" {
+		t.Skip("Skipping test: NIOS_JOIN_MEMBER_URL must be set to a member that is not part of a grid")
+	}
+	master, err := url.Parse(os.Getenv("NIOS_HOST_URL"))
+	if err != nil {
+		t.Fatalf("Invalid NIOS_HOST_URL: %s", err)
+	}
+	hostName := acctest.RandomNameWithPrefix("join-member") + ".com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Timeout below the minimum
+			{
+				Config:      testAccGridJoinJoinTimeout(memberURL, master.Hostname(), hostName, 30),
+				ExpectError: regexp.MustCompile(`Attribute join_timeout value must be at least 60`),
+			},
+			// The join uses a wrong shared secret, so the member never comes online and the wait times out
+			{
+				Config:      testAccGridJoinJoinTimeout(memberURL, master.Hostname(), hostName, 60),
+				ExpectError: regexp.MustCompile(`Unable to find the member on the grid master within 1m0s`),
+			},
+		},
+	})
+}
+
+func testAccGridJoinJoinTimeout(memberURL, master, hostName string, joinTimeout int) string {
+	return fmt.Sprintf(`
+resource "nios_grid_join" "test_join_timeout" {
+  member_url       = %q
+  member_username  = %q
+  member_password  = %q
+  grid_name        = "Infoblox"
+  master           = %q
+  shared_secret    = "not-the-shared-secret"
+  member_host_name = %q
+  join_timeout     = %d
+}
+`, memberURL, os.Getenv("NIOS_USERNAME"), os.Getenv("NIOS_PASSWORD"), master, hostName, joinTimeout)
+}
@@ -0,0 +1,408 @@
+package grid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// errLicenseAllocationPending is returned while the allocated licenses are not yet installed on the member
+var errLicenseAllocationPending = errors.New("allocated licenses are not installed on the member yet")
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GridLicensePoolAllocationResource{}
+
+func NewGridLicensePoolAllocationResource() resource.Resource {
+	return &GridLicensePoolAllocationResource{}
+}
+
+// GridLicensePoolAllocationResource defines the resource implementation.
+type GridLicensePoolAllocationResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *GridLicensePoolAllocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "grid_license_pool_allocation"
+}
+
+func (r *GridLicensePoolAllocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Allocates dynamic licenses from the Grid license pools to a vNIOS member and waits until they are installed. Use it after joining a member to make sure its services are licensed before they are started.",
+		Attributes:          GridLicensePoolAllocationResourceSchemaAttributes,
+	}
+}
+
+func (r *GridLicensePoolAllocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GridLicensePoolAllocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GridLicensePoolAllocationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	licenses := flex.ExpandFrameworkListString(ctx, data.Licenses, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.allocate(ctx, &data, licenses, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GridLicensePoolAllocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GridLicensePoolAllocationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hwids, err := r.memberHwids(ctx, data.Member.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read member %s, got error: %s", data.Member.ValueString(), err))
+		return
+	}
+	if hwids == nil {
+		// Member no longer exists, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	licenses := flex.ExpandFrameworkListString(ctx, data.Licenses, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	installed, err := r.memberLicenses(ctx, hwids, licenses)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Member License, got error: %s", err))
+		return
+	}
+
+	// Only report the license types that are still installed, so removed licenses show up as drift
+	var present []string
+	for _, licenseType := range licenses {
+		if slices.ContainsFunc(installed, func(l grid.MemberLicense) bool { return l.GetType() == licenseType }) {
+			present = append(present, licenseType)
+		}
+	}
+	if len(present) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Licenses = flex.FlattenFrameworkListString(ctx, present, &resp.Diagnostics)
+	data.AllocatedLicenses = flex.FlattenFrameworkListNestedBlock(ctx, installed, MemberLicenseAttrTypes, &resp.Diagnostics, FlattenMemberLicense)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GridLicensePoolAllocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GridLicensePoolAllocationModel
+
+	// Read Terraform plan and prior state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := flex.ExpandFrameworkListString(ctx, data.Licenses, &resp.Diagnostics)
+	allocated := flex.ExpandFrameworkListString(ctx, state.Licenses, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var added, removed []string
+	for _, licenseType := range planned {
+		if !slices.Contains(allocated, licenseType) {
+			added = append(added, licenseType)
+		}
+	}
+	for _, licenseType := range allocated {
+		if !slices.Contains(planned, licenseType) {
+			removed = append(removed, licenseType)
+		}
+	}
+
+	if len(removed) > 0 {
+		r.release(ctx, data.Member.ValueString(), removed, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.allocate(ctx, &data, added, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GridLicensePoolAllocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GridLicensePoolAllocationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	licenses := flex.ExpandFrameworkListString(ctx, data.Licenses, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.release(ctx, data.Member.ValueString(), licenses, &resp.Diagnostics)
+}
+
+// allocate allocates the given license types to the member, then waits until all licenses in the plan are installed.
+func (r *GridLicensePoolAllocationResource) allocate(ctx context.Context, data *GridLicensePoolAllocationModel, licenses []string, diags *diag.Diagnostics) {
+	member := data.Member.ValueString()
+
+	if len(licenses) > 0 {
+		containerRef, err := r.licensePoolContainerRef(ctx)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read Grid License Pool Container, got error: %s", err))
+			return
+		}
+
+		tflog.Info(ctx, "Allocating licenses from license pools", map[string]any{"member": member, "licenses": licenses})
+		_, err = utils.CallWAPIFunction(
+			ctx,
+			r.client.GridAPI.Cfg.NIOSHostURL,
+			r.client.GridAPI.Cfg.NIOSUsername,
+			r.client.GridAPI.Cfg.NIOSPassword,
+			containerRef,
+			"allocate_licenses",
+			map[string]any{
+				"member":   member,
+				"licenses": licenses,
+			},
+		)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to allocate licenses %v to member %s, got error: %s", licenses, member, err))
+			return
+		}
+	}
+
+	planned := flex.ExpandFrameworkListString(ctx, data.Licenses, diags)
+	if diags.HasError() {
+		return
+	}
+
+	var (
+		installed []grid.MemberLicense
+		missing   []string
+	)
+
+	timeout := time.Duration(data.WaitTimeout.ValueInt64()) * time.Second
+	err := retry.DoWithTimeout(ctx, timeout, isLicenseAllocationPending, func(ctx context.Context) (int, error) {
+		hwids, callErr := r.memberHwids(ctx, member)
+		if callErr != nil {
+			return 0, callErr
+		}
+		if hwids == nil {
+			return 0, fmt.Errorf("member %s not found", member)
+		}
+
+		installed, callErr = r.memberLicenses(ctx, hwids, planned)
+		if callErr != nil {
+			return 0, callErr
+		}
+
+		missing = missing[:0]
+		for _, licenseType := range planned {
+			if !slices.ContainsFunc(installed, func(l grid.MemberLicense) bool { return l.GetType() == licenseType }) {
+				missing = append(missing, licenseType)
+			}
+		}
+		if len(missing) > 0 {
+			return 0, errLicenseAllocationPending
+		}
+		return 0, nil
+	})
+	if err != nil {
+		if len(missing) > 0 {
+			diags.AddError(
+				"License Allocation Failed",
+				fmt.Sprintf("Licenses %v were not installed on member %s within %s. Make sure the license pools have free licenses for the member's model.", missing, member, timeout),
+			)
+			return
+		}
+		diags.AddError("License Allocation Failed", fmt.Sprintf("Unable to verify licenses of member %s, got error: %s", member, err))
+		return
+	}
+
+	data.AllocatedLicenses = flex.FlattenFrameworkListNestedBlock(ctx, installed, MemberLicenseAttrTypes, diags, FlattenMemberLicense)
+}
+
+// release deletes the dynamic licenses of the given types from the member, which returns them to their pools.
+func (r *GridLicensePoolAllocationResource) release(ctx context.Context, member string, licenses []string, diags *diag.Diagnostics) {
+	hwids, err := r.memberHwids(ctx, member)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read member %s, got error: %s", member, err))
+		return
+	}
+	if hwids == nil {
+		return
+	}
+
+	installed, err := r.memberLicenses(ctx, hwids, licenses)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read Member License, got error: %s", err))
+		return
+	}
+
+	for _, license := range installed {
+		// Static licenses were not allocated from a pool
+		if !strings.EqualFold(license.GetKind(), "dynamic") {
+			continue
+		}
+
+		resourceRef := utils.ExtractResourceRef(license.GetRef())
+		err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+			httpRes, callErr := r.client.GridAPI.
+				MemberLicenseAPI.
+				Delete(ctx, resourceRef).
+				Execute()
+
+			if httpRes != nil {
+				if httpRes.StatusCode == http.StatusNotFound {
+					return 0, nil
+				}
+				return httpRes.StatusCode, callErr
+			}
+			return 0, callErr
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to release %s license of member %s, got error: %s", license.GetType(), member, err))
+			return
+		}
+	}
+}
+
+func (r *GridLicensePoolAllocationResource) licensePoolContainerRef(ctx context.Context) (string, error) {
+	apiRes, _, err := r.client.GridAPI.
+		GridLicensePoolContainerAPI.
+		List(ctx).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		return "", err
+	}
+
+	containers := apiRes.ListGridLicensePoolContainerResponseObject.GetResult()
+	if len(containers) == 0 {
+		return "", errors.New("no license pool container found, make sure license pools are installed on the Grid")
+	}
+	return containers[0].GetRef(), nil
+}
+
+// memberHwids returns the hardware IDs of the nodes of the member. It returns nil if the member does not exist.
+func (r *GridLicensePoolAllocationResource) memberHwids(ctx context.Context, hostName string) ([]string, error) {
+	apiRes, _, err := r.client.GridAPI.
+		MemberAPI.
+		List(ctx).
+		Filters(map[string]interface{}{
+			"host_name": hostName,
+		}).
+		ReturnFields("host_name,node_info").
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	members := apiRes.ListMemberResponseObject.GetResult()
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	hwids := []string{}
+	for _, node := range members[0].GetNodeInfo() {
+		if node.GetHwid() != "" {
+			hwids = append(hwids, node.GetHwid())
+		}
+	}
+	return hwids, nil
+}
+
+// memberLicenses returns the licenses of the given types installed on the nodes with the given hardware IDs.
+func (r *GridLicensePoolAllocationResource) memberLicenses(ctx context.Context, hwids, licenses []string) ([]grid.MemberLicense, error) {
+	apiRes, _, err := r.client.GridAPI.
+		MemberLicenseAPI.
+		List(ctx).
+		ReturnFieldsPlus(readableAttributesForMemberLicense).
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []grid.MemberLicense
+	for _, license := range apiRes.ListMemberLicenseResponseObject.GetResult() {
+		if slices.Contains(hwids, license.GetHwid()) && slices.Contains(licenses, license.GetType()) {
+			result = append(result, license)
+		}
+	}
+	return result, nil
+}
+
+// isLicenseAllocationPending checks if the error indicates that the allocated licenses are not installed yet.
+func isLicenseAllocationPending(err error) bool {
+	return errors.Is(err, errLicenseAllocationPending) || retry.TransientErrors(err)
+}
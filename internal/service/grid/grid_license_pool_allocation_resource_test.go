@@ -0,0 +1,68 @@
+package grid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// - vNIOS member set using NIOS_GRID_MEMBER_HOSTNAME, without DNS and DHCP licenses installed
+// - License pools with free DNS and DHCP licenses for the model of the member
+
+func TestAccGridLicensePoolAllocationResource_basic(t *testing.T) {
+	member := utils.GetNIOSGridMemberHostName()
+	if member == "" {
+		t.Skip("Skipping test: NIOS_GRID_MEMBER_HOSTNAME must be set to a vNIOS member")
+	}
+	var resourceName = "nios_grid_license_pool_allocation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridLicensePoolAllocationBasicConfig(member, `["DNS"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "member", member),
+					resource.TestCheckResourceAttr(resourceName, "licenses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allocated_licenses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allocated_licenses.0.type", "DNS"),
+					resource.TestCheckResourceAttrSet(resourceName, "allocated_licenses.0.expiry_date"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridLicensePoolAllocationBasicConfig(member, `["DNS", "DHCP"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "licenses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "allocated_licenses.#", "2"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridLicensePoolAllocationBasicConfig(member, `["DHCP"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "licenses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allocated_licenses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allocated_licenses.0.type", "DHCP"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGridLicensePoolAllocationBasicConfig(member, licenses string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_license_pool_allocation" "test" {
+	member   = %q
+	licenses = %s
+}
+`, member, licenses)
+}
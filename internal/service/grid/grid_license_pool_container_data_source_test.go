@@ -0,0 +1,40 @@
+package grid_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// - License pools installed on the Grid
+
+func TestAccGridLicensePoolContainerDataSource_Read(t *testing.T) {
+	dataSourceName := "data.nios_grid_license_pool_container.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGridLicensePoolContainerDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.lpc_uid"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccGridLicensePoolContainerDataSourceConfig() string {
+	return `
+data "nios_grid_license_pool_container" "test" {}
+`
+}
@@ -0,0 +1,46 @@
+package grid_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// - License pool for vNIOS DNS licenses
+
+func TestAccGridLicensePoolDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_grid_license_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGridLicensePoolDataSourceConfigFilters(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.type", "DNS"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.installed"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.assigned"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.expiry_date"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccGridLicensePoolDataSourceConfigFilters() string {
+	return `
+data "nios_grid_license_pool" "test" {
+  filters = {
+	type = "DNS"
+  }
+}
+`
+}
@@ -0,0 +1,42 @@
+package grid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccGridMaxminddbinfoDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_grid_maxminddbinfo.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGridMaxminddbinfoDataSourceConfigFilters("GEOIP"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.topology_type", "GEOIP"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.database_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.build_time"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccGridMaxminddbinfoDataSourceConfigFilters(topologyType string) string {
+	return fmt.Sprintf(`
+data "nios_grid_maxminddbinfo" "test" {
+  filters = {
+	topology_type = %q
+  }
+}
+`, topologyType)
+}
@@ -0,0 +1,240 @@
+package grid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	gridclient "github.com/infobloxopen/infoblox-nios-go-client/grid"
+	"github.com/infobloxopen/infoblox-nios-go-client/option"
+)
+
+// newUpgradeTestResource returns a GridUpgradeResource backed by a WAPI stub that reports the given member upgrade statuses,
+// one response per poll. The last response is repeated once all are used.
+func newUpgradeTestResource(t *testing.T, polls ...[]map[string]any) *GridUpgradeResource {
+	t.Helper()
+	poll := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/upgradestatus") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		result := polls[min(poll, len(polls)-1)]
+		poll++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": result})
+	}))
+	t.Cleanup(server.Close)
+
+	client := niosclient.NewAPIClient(
+		option.WithNIOSHostUrl(server.URL),
+		option.WithNIOSUsername("admin"),
+		option.WithNIOSPassword("infoblox"),
+		option.WithDebug(false),
+	)
+	return &GridUpgradeResource{client: client}
+}
+
+func testUpgradeMember(name, currentVersion, status string) map[string]any {
+	return map[string]any{
+		"_ref":            "upgradestatus/" + name,
+		"member":          name,
+		"type":            "MEMBER",
+		"current_version": currentVersion,
+		"status_value":    status,
+		"message":         status + " message",
+	}
+}
+
+func TestWaitForMembers(t *testing.T) {
+	isUpgraded := func(member *gridclient.Upgradestatus) bool {
+		return member.GetCurrentVersion() == "9.0.6"
+	}
+
+	tests := []struct {
+		name    string
+		polls   [][]map[string]any
+		wantErr string
+	}{
+		{
+			name: "all members upgraded",
+			polls: [][]map[string]any{
+				{testUpgradeMember("member1", "9.0.5", "UPGRADING"), testUpgradeMember("member2", "9.0.5", "UPGRADING")},
+				{testUpgradeMember("member1", "9.0.6", "COMPLETED"), testUpgradeMember("member2", "9.0.5", "UPGRADING")},
+				{testUpgradeMember("member1", "9.0.6", "COMPLETED"), testUpgradeMember("member2", "9.0.6", "COMPLETED")},
+			},
+		},
+		{
+			name: "member failed",
+			polls: [][]map[string]any{
+				{testUpgradeMember("member1", "9.0.6", "COMPLETED"), testUpgradeMember("member2", "9.0.5", "FAILED")},
+			},
+			wantErr: "member member2 failed during upgrade: FAILED message",
+		},
+		{
+			name:    "no members",
+			polls:   [][]map[string]any{{}},
+			wantErr: `no members found for upgrade group "site-a"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newUpgradeTestResource(t, tt.polls...)
+			err := r.waitForMembers(context.Background(), "upgrade", "site-a", 30*time.Second, isUpgraded)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("Expected error %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWaitForMembers_Timeout(t *testing.T) {
+	r := newUpgradeTestResource(t, []map[string]any{testUpgradeMember("member1", "9.0.5", "UPGRADING")})
+
+	err := r.waitForMembers(context.Background(), "upgrade", "", 2*time.Second, func(member *gridclient.Upgradestatus) bool {
+		return member.GetCurrentVersion() == "9.0.6"
+	})
+	if err == nil {
+		t.Fatal("Expected timeout error, got nil")
+	}
+}
+
+func TestWarnOnUpgradeVersionDrift(t *testing.T) {
+	tests := []struct {
+		name           string
+		upgrade        bool
+		currentVersion string
+		wantWarning    bool
+	}{
+		{"upgraded", true, "9.0.6", false},
+		{"reverted", true, "9.0.5", true},
+		{"distribute only", false, "9.0.5", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			warnOnUpgradeVersionDrift(&GridUpgradeModel{
+				Upgrade:        types.BoolValue(tt.upgrade),
+				TargetVersion:  types.StringValue("9.0.6"),
+				CurrentVersion: types.StringValue(tt.currentVersion),
+			}, &diags)
+			if got := diags.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("Expected warning %t, got diagnostics: %v", tt.wantWarning, diags)
+			}
+			if diags.HasError() {
+				t.Errorf("Unexpected error diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestIsGridUpgradeImageChanged(t *testing.T) {
+	tests := []struct {
+		name        string
+		stateImage  types.String
+		planImage   types.String
+		wantChanged bool
+	}{
+		{"same image", types.StringValue("/images/nios-9.0.5.bin"), types.StringValue("/images/nios-9.0.5.bin"), false},
+		{"new image", types.StringValue("/images/nios-9.0.5.bin"), types.StringValue("/images/nios-9.0.6.bin"), true},
+		{"image set", types.StringNull(), types.StringValue("/images/nios-9.0.6.bin"), true},
+		{"image removed", types.StringValue("/images/nios-9.0.5.bin"), types.StringNull(), false},
+		{"image unknown", types.StringValue("/images/nios-9.0.5.bin"), types.StringUnknown(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isGridUpgradeImageChanged(&GridUpgradeModel{ImageFilePath: tt.stateImage}, &GridUpgradeModel{ImageFilePath: tt.planImage})
+			if got != tt.wantChanged {
+				t.Errorf("Expected changed %t, got %t", tt.wantChanged, got)
+			}
+		})
+	}
+}
+
+// TestGridUpgradeModifyPlan_NewImage tests that a new image is planned as an update. Replacing the resource would
+// revert the Grid before the new image is upgraded to when revert_on_destroy is set.
+func TestGridUpgradeModifyPlan_NewImage(t *testing.T) {
+	ctx := context.Background()
+	r := &GridUpgradeResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if len(GridUpgradeResourceSchemaAttributes["image_file_path"].(schema.StringAttribute).PlanModifiers) != 0 {
+		t.Fatal("Expected image_file_path not to replace the resource")
+	}
+
+	model := func(imageFilePath string) GridUpgradeModel {
+		return GridUpgradeModel{
+			ImageFilePath:       types.StringValue(imageFilePath),
+			TestUpgrade:         types.BoolValue(false),
+			Distribute:          types.BoolValue(true),
+			Upgrade:             types.BoolValue(true),
+			UpgradeGroups:       types.ListNull(types.StringType),
+			RevertOnDestroy:     types.BoolValue(true),
+			WaitTimeout:         types.Int64Value(7200),
+			TargetVersion:       types.StringValue("9.0.5"),
+			PreviousVersion:     types.StringValue("9.0.4"),
+			CurrentVersion:      types.StringValue("9.0.5"),
+			DistributionVersion: types.StringValue("9.0.5"),
+			UpgradeTestStatus:   types.StringValue(""),
+			MemberStatus:        types.ListNull(types.ObjectType{AttrTypes: UpgradestatusAttrTypes}),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		planImage   string
+		wantUnknown bool
+	}{
+		{"same image", "/images/nios-9.0.5.bin", false},
+		{"new image", "/images/nios-9.0.6.bin", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			diags := state.Set(ctx, model("/images/nios-9.0.5.bin"))
+			diags.Append(plan.Set(ctx, model(tt.planImage))...)
+			if diags.HasError() {
+				t.Fatalf("Unexpected error building the plan: %v", diags)
+			}
+
+			req := resource.ModifyPlanRequest{State: state, Plan: plan}
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+			if len(resp.RequiresReplace) != 0 {
+				t.Errorf("Expected no replacement, got: %v", resp.RequiresReplace)
+			}
+
+			var targetVersion, previousVersion types.String
+			resp.Plan.GetAttribute(ctx, path.Root("target_version"), &targetVersion)
+			resp.Plan.GetAttribute(ctx, path.Root("previous_version"), &previousVersion)
+			if targetVersion.IsUnknown() != tt.wantUnknown || previousVersion.IsUnknown() != tt.wantUnknown {
+				t.Errorf("Expected versions unknown %t, got target_version %s and previous_version %s", tt.wantUnknown, targetVersion, previousVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,587 @@
+package grid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	gridclient "github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+const gridMasterUpgradeGroup = "Grid Master"
+
+// errGridUpgradePending is returned while an upgrade phase has not completed yet
+var errGridUpgradePending = errors.New("grid upgrade phase has not completed yet")
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GridUpgradeResource{}
+var _ resource.ResourceWithValidateConfig = &GridUpgradeResource{}
+var _ resource.ResourceWithModifyPlan = &GridUpgradeResource{}
+
+func NewGridUpgradeResource() resource.Resource {
+	return &GridUpgradeResource{}
+}
+
+// GridUpgradeResource defines the resource implementation.
+type GridUpgradeResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *GridUpgradeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "grid_upgrade"
+}
+
+func (r *GridUpgradeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Orchestrates a Grid upgrade: uploads an upgrade image, optionally runs a test upgrade, distributes the image and upgrades the Grid, waiting for each phase to complete. All phases run on create and run again with the new image when `image_file_path` changes. The other phase settings cannot be changed afterwards.",
+		Attributes:          GridUpgradeResourceSchemaAttributes,
+	}
+}
+
+func (r *GridUpgradeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GridUpgradeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GridUpgradeModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.run(ctx, &data, &resp.Diagnostics) {
+		return
+	}
+
+	r.refresh(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GridUpgradeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GridUpgradeModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refresh(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warnOnUpgradeVersionDrift(&data, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GridUpgradeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan GridUpgradeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new image is upgraded to in place, replacing the resource would revert the Grid first when revert_on_destroy is set
+	if isGridUpgradeImageChanged(&data, &plan) {
+		if !r.run(ctx, &plan, &resp.Diagnostics) {
+			return
+		}
+		r.refresh(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// Otherwise only revert_on_destroy, wait_timeout and the path of the already uploaded image can change
+	data.ImageFilePath = plan.ImageFilePath
+	data.RevertOnDestroy = plan.RevertOnDestroy
+	data.WaitTimeout = plan.WaitTimeout
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GridUpgradeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GridUpgradeModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The upgrade cannot be undone by deleting an object, the Grid is only reverted on request
+	if !data.RevertOnDestroy.ValueBool() || !data.Upgrade.ValueBool() {
+		return
+	}
+
+	gridRef, err := r.gridRef(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Grid, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Reverting Grid upgrade", map[string]any{"previous_version": data.PreviousVersion.ValueString()})
+	if !r.callUpgradeFunction(ctx, gridRef, "upgrade", map[string]any{"action": "REVERT"}, &resp.Diagnostics) {
+		return
+	}
+
+	previousVersion := data.PreviousVersion.ValueString()
+	timeout := time.Duration(data.WaitTimeout.ValueInt64()) * time.Second
+	err = r.waitForMembers(ctx, "revert", "", timeout, func(member *gridclient.Upgradestatus) bool {
+		return member.GetCurrentVersion() == previousVersion
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Grid Upgrade Revert Failed",
+			fmt.Sprintf("Grid did not revert to version %s within %s, got error: %s", previousVersion, timeout, err),
+		)
+	}
+}
+
+func (r *GridUpgradeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan GridUpgradeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The versions are only known once the new image has been uploaded
+	if isGridUpgradeImageChanged(&state, &plan) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("target_version"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_version"), types.StringUnknown())...)
+	}
+}
+
+func (r *GridUpgradeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GridUpgradeModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.UpgradeGroups.IsNull() && !data.UpgradeGroups.IsUnknown() && !data.Upgrade.IsUnknown() && !data.Upgrade.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("upgrade_groups"),
+			"Invalid Configuration",
+			"upgrade_groups can only be set when upgrade is set to true.",
+		)
+	}
+}
+
+// run uploads the image, runs the test upgrade, distributes the image and upgrades the Grid as configured.
+func (r *GridUpgradeResource) run(ctx context.Context, data *GridUpgradeModel, diags *diag.Diagnostics) bool {
+	gridRef, err := r.gridRef(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read Grid, got error: %s", err))
+		return false
+	}
+
+	gridStatus, err := r.gridUpgradestatus(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read Grid upgrade status, got error: %s", err))
+		return false
+	}
+	data.PreviousVersion = types.StringValue(gridStatus.GetCurrentVersion())
+
+	timeout := time.Duration(data.WaitTimeout.ValueInt64()) * time.Second
+
+	// Upload the image, or use the image that is already uploaded
+	if !data.ImageFilePath.IsNull() && data.ImageFilePath.ValueString() != "" {
+		if !r.uploadImage(ctx, gridRef, data, timeout, diags) {
+			return false
+		}
+	} else {
+		if gridStatus.GetUploadVersion() == "" {
+			diags.AddError(
+				"Grid Upgrade Failed",
+				"No upgrade image is uploaded to the Grid. Set image_file_path to upload one.",
+			)
+			return false
+		}
+		data.TargetVersion = types.StringValue(gridStatus.GetUploadVersion())
+	}
+
+	if data.TestUpgrade.ValueBool() {
+		if !r.testUpgrade(ctx, gridRef, timeout, diags) {
+			return false
+		}
+	}
+
+	if data.Distribute.ValueBool() || data.Upgrade.ValueBool() {
+		if !r.distribute(ctx, gridRef, data.TargetVersion.ValueString(), timeout, diags) {
+			return false
+		}
+	}
+
+	if data.Upgrade.ValueBool() {
+		if !r.upgrade(ctx, gridRef, data, timeout, diags) {
+			return false
+		}
+	}
+	return true
+}
+
+// uploadImage uploads the upgrade image to the Grid and waits until the Grid reports the uploaded version.
+func (r *GridUpgradeResource) uploadImage(ctx context.Context, gridRef string, data *GridUpgradeModel, timeout time.Duration, diags *diag.Diagnostics) bool {
+	baseUrl := r.client.GridAPI.Cfg.NIOSHostURL
+	username := r.client.GridAPI.Cfg.NIOSUsername
+	password := r.client.GridAPI.Cfg.NIOSPassword
+
+	filePath := data.ImageFilePath.ValueString()
+
+	tflog.Info(ctx, "Uploading Grid upgrade image", map[string]any{"image_file_path": filePath})
+	token, err := utils.UploadFileWithToken(ctx, baseUrl, filePath, username, password)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to upload upgrade image %s, got error: %s", filePath, err),
+		)
+		return false
+	}
+
+	if !r.callUpgradeFunction(ctx, gridRef, "upgrade", map[string]any{"action": "UPLOAD", "token": token}, diags) {
+		return false
+	}
+
+	var version string
+	err = r.waitForGrid(ctx, "upload", timeout, func(grid *gridclient.Upgradestatus) (bool, error) {
+		version = grid.GetUploadVersion()
+		return version != "", nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Grid Upgrade Failed",
+			fmt.Sprintf("Upgrade image %s was not processed within %s, got error: %s", filePath, timeout, err),
+		)
+		return false
+	}
+
+	data.TargetVersion = types.StringValue(version)
+	return true
+}
+
+// testUpgrade runs a test upgrade and waits for its result.
+func (r *GridUpgradeResource) testUpgrade(ctx context.Context, gridRef string, timeout time.Duration, diags *diag.Diagnostics) bool {
+	tflog.Info(ctx, "Starting Grid test upgrade")
+	if !r.callUpgradeFunction(ctx, gridRef, "upgrade", map[string]any{"action": "UPGRADE_TEST_START"}, diags) {
+		return false
+	}
+
+	err := r.waitForGrid(ctx, "test upgrade", timeout, func(grid *gridclient.Upgradestatus) (bool, error) {
+		switch grid.GetUpgradeTestStatus() {
+		case "COMPLETED":
+			return true, nil
+		case "FAILED":
+			return false, fmt.Errorf("test upgrade failed: %s", grid.GetMessage())
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		diags.AddError("Grid Test Upgrade Failed", fmt.Sprintf("Test upgrade did not complete within %s, got error: %s", timeout, err))
+		return false
+	}
+	return true
+}
+
+// distribute starts the distribution of the uploaded image and waits until every member has received it.
+func (r *GridUpgradeResource) distribute(ctx context.Context, gridRef, targetVersion string, timeout time.Duration, diags *diag.Diagnostics) bool {
+	tflog.Info(ctx, "Starting Grid upgrade distribution", map[string]any{"target_version": targetVersion})
+	if !r.callUpgradeFunction(ctx, gridRef, "upgrade", map[string]any{"action": "DISTRIBUTION_START"}, diags) {
+		return false
+	}
+
+	err := r.waitForMembers(ctx, "distribution", "", timeout, func(member *gridclient.Upgradestatus) bool {
+		return member.GetDistributionVersion() == targetVersion
+	})
+	if err != nil {
+		diags.AddError(
+			"Grid Upgrade Distribution Failed",
+			fmt.Sprintf("Version %s was not distributed to all members within %s, got error: %s", targetVersion, timeout, err),
+		)
+		return false
+	}
+	return true
+}
+
+// upgrade upgrades the Grid. Without upgrade groups the whole Grid is upgraded at once, otherwise the
+// Grid Master is upgraded first and each group is upgraded and verified in order.
+func (r *GridUpgradeResource) upgrade(ctx context.Context, gridRef string, data *GridUpgradeModel, timeout time.Duration, diags *diag.Diagnostics) bool {
+	targetVersion := data.TargetVersion.ValueString()
+	isUpgraded := func(member *gridclient.Upgradestatus) bool {
+		return member.GetCurrentVersion() == targetVersion
+	}
+
+	tflog.Info(ctx, "Starting Grid upgrade", map[string]any{"target_version": targetVersion})
+	if !r.callUpgradeFunction(ctx, gridRef, "upgrade", map[string]any{"action": "UPGRADE"}, diags) {
+		return false
+	}
+
+	upgradeGroups := flex.ExpandFrameworkListString(ctx, data.UpgradeGroups, diags)
+	if diags.HasError() {
+		return false
+	}
+
+	if len(upgradeGroups) == 0 {
+		if err := r.waitForMembers(ctx, "upgrade", "", timeout, isUpgraded); err != nil {
+			diags.AddError(
+				"Grid Upgrade Failed",
+				fmt.Sprintf("Grid was not upgraded to version %s within %s, got error: %s", targetVersion, timeout, err),
+			)
+			return false
+		}
+		return true
+	}
+
+	if err := r.waitForMembers(ctx, "upgrade", gridMasterUpgradeGroup, timeout, isUpgraded); err != nil {
+		diags.AddError(
+			"Grid Upgrade Failed",
+			fmt.Sprintf("Grid Master was not upgraded to version %s within %s, got error: %s", targetVersion, timeout, err),
+		)
+		return false
+	}
+
+	for _, group := range upgradeGroups {
+		if group == gridMasterUpgradeGroup {
+			continue
+		}
+		tflog.Info(ctx, "Upgrading upgrade group", map[string]any{"upgrade_group": group})
+		if !r.callUpgradeFunction(ctx, gridRef, "upgrade_group_now", map[string]any{"upgrade_group": group}, diags) {
+			return false
+		}
+		if err := r.waitForMembers(ctx, "upgrade", group, timeout, isUpgraded); err != nil {
+			diags.AddError(
+				"Grid Upgrade Failed",
+				fmt.Sprintf("Upgrade group %s was not upgraded to version %s within %s, got error: %s", group, targetVersion, timeout, err),
+			)
+			return false
+		}
+	}
+	return true
+}
+
+// refresh reads the current Grid and member upgrade status into the computed attributes.
+func (r *GridUpgradeResource) refresh(ctx context.Context, data *GridUpgradeModel, diags *diag.Diagnostics) {
+	grid, err := r.gridUpgradestatus(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read Grid upgrade status, got error: %s", err))
+		return
+	}
+
+	members, err := r.listUpgradestatus(ctx, "MEMBER", "")
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read member upgrade status, got error: %s", err))
+		return
+	}
+
+	data.CurrentVersion = types.StringValue(grid.GetCurrentVersion())
+	data.DistributionVersion = types.StringValue(grid.GetDistributionVersion())
+	data.UpgradeTestStatus = types.StringValue(grid.GetUpgradeTestStatus())
+	data.MemberStatus = flex.FlattenFrameworkListNestedBlock(ctx, members, UpgradestatusAttrTypes, diags, FlattenUpgradestatus)
+}
+
+// isGridUpgradeImageChanged reports whether a new image is configured, which runs all phases of the upgrade again.
+// Removing the image path keeps the Grid on the image that is already uploaded.
+func isGridUpgradeImageChanged(state, plan *GridUpgradeModel) bool {
+	if plan.ImageFilePath.IsNull() || plan.ImageFilePath.IsUnknown() || plan.ImageFilePath.ValueString() == "" {
+		return false
+	}
+	return !plan.ImageFilePath.Equal(state.ImageFilePath)
+}
+
+// warnOnUpgradeVersionDrift warns when the Grid no longer runs the target version of the upgrade, e.g. after it was
+// reverted outside Terraform. The upgrade is not repeated on its own, the resource has to be replaced.
+func warnOnUpgradeVersionDrift(data *GridUpgradeModel, diags *diag.Diagnostics) {
+	if !data.Upgrade.ValueBool() || data.CurrentVersion.ValueString() == data.TargetVersion.ValueString() {
+		return
+	}
+	diags.AddWarning(
+		"Grid Upgrade Version Drift",
+		fmt.Sprintf("The Grid runs version %s instead of the target version %s of this upgrade. Replace the resource, e.g. with terraform apply -replace, to upgrade the Grid again. "+
+			"If revert_on_destroy is true, the replacement reverts the Grid before it is upgraded.", data.CurrentVersion.ValueString(), data.TargetVersion.ValueString()),
+	)
+}
+
+// waitForGrid polls the Grid upgrade status until done reports completion, done returns an error or the timeout expires.
+func (r *GridUpgradeResource) waitForGrid(ctx context.Context, phase string, timeout time.Duration, done func(*gridclient.Upgradestatus) (bool, error)) error {
+	return retry.DoWithTimeout(ctx, timeout, isGridUpgradePending, func(ctx context.Context) (int, error) {
+		grid, err := r.gridUpgradestatus(ctx)
+		if err != nil {
+			return 0, err
+		}
+		complete, err := done(grid)
+		if err != nil {
+			return 0, err
+		}
+		if !complete {
+			tflog.Info(ctx, fmt.Sprintf("Waiting for Grid %s to complete", phase), map[string]any{
+				"grid_state": grid.GetGridState(),
+				"message":    grid.GetMessage(),
+			})
+			return 0, errGridUpgradePending
+		}
+		return 0, nil
+	})
+}
+
+// waitForMembers polls the member upgrade status until done reports completion for every member,
+// optionally limited to one upgrade group. A member that reports a failed status stops the wait.
+func (r *GridUpgradeResource) waitForMembers(ctx context.Context, phase, upgradeGroup string, timeout time.Duration, done func(*gridclient.Upgradestatus) bool) error {
+	return retry.DoWithTimeout(ctx, timeout, isGridUpgradePending, func(ctx context.Context) (int, error) {
+		members, err := r.listUpgradestatus(ctx, "MEMBER", upgradeGroup)
+		if err != nil {
+			return 0, err
+		}
+		if len(members) == 0 {
+			return 0, fmt.Errorf("no members found for upgrade group %q", upgradeGroup)
+		}
+
+		completed := 0
+		for i := range members {
+			member := &members[i]
+			if member.GetStatusValue() == "FAILED" {
+				return 0, fmt.Errorf("member %s failed during %s: %s", member.GetMember(), phase, member.GetMessage())
+			}
+			if done(member) {
+				completed++
+			}
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Waiting for Grid %s to complete", phase), map[string]any{
+			"upgrade_group": upgradeGroup,
+			"completed":     completed,
+			"total":         len(members),
+		})
+		if completed < len(members) {
+			return 0, errGridUpgradePending
+		}
+		return 0, nil
+	})
+}
+
+// callUpgradeFunction calls an upgrade function on the Grid object. The client does not model the
+// upgrade functions, so they are called through the WAPI directly.
+func (r *GridUpgradeResource) callUpgradeFunction(ctx context.Context, gridRef, function string, args map[string]any, diags *diag.Diagnostics) bool {
+	baseUrl := r.client.GridAPI.Cfg.NIOSHostURL
+	username := r.client.GridAPI.Cfg.NIOSUsername
+	password := r.client.GridAPI.Cfg.NIOSPassword
+
+	_, err := utils.CallWAPIFunction(ctx, baseUrl, username, password, gridRef, function, args)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to call Grid %s function, got error: %s", function, err))
+		return false
+	}
+	return true
+}
+
+func (r *GridUpgradeResource) gridRef(ctx context.Context) (string, error) {
+	apiRes, _, err := r.client.GridAPI.
+		GridAPI.
+		List(ctx).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		return "", err
+	}
+
+	grids := apiRes.ListGridResponseObject.GetResult()
+	if len(grids) == 0 {
+		return "", errors.New("no Grid object found")
+	}
+	return grids[0].GetRef(), nil
+}
+
+func (r *GridUpgradeResource) gridUpgradestatus(ctx context.Context) (*gridclient.Upgradestatus, error) {
+	grids, err := r.listUpgradestatus(ctx, "GRID", "")
+	if err != nil {
+		return nil, err
+	}
+	if len(grids) == 0 {
+		return nil, errors.New("no Grid upgrade status found")
+	}
+	return &grids[0], nil
+}
+
+func (r *GridUpgradeResource) listUpgradestatus(ctx context.Context, statusType, upgradeGroup string) ([]gridclient.Upgradestatus, error) {
+	filters := map[string]interface{}{
+		"type": statusType,
+	}
+	if upgradeGroup != "" {
+		filters["upgrade_group"] = upgradeGroup
+	}
+
+	apiRes, _, err := r.client.GridAPI.
+		UpgradestatusAPI.
+		List(ctx).
+		Filters(filters).
+		ReturnFieldsPlus(readableAttributesForUpgradestatus).
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	return apiRes.ListUpgradestatusResponseObject.GetResult(), nil
+}
+
+// isGridUpgradePending checks if the error indicates that an upgrade phase is still in progress.
+// Network errors are expected while members restart during the upgrade.
+func isGridUpgradePending(err error) bool {
+	return errors.Is(err, errGridUpgradePending) || retry.IsNetworkError(err) || retry.TransientErrors(err)
+}
@@ -0,0 +1,81 @@
+package grid_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO: OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// Upgrade image uploaded to the Grid. The tests neither distribute nor upgrade it.
+
+func TestAccGridUpgradeResource_basic(t *testing.T) {
+	var resourceName = "nios_grid_upgrade.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridUpgradeBasicConfig(false, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "target_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "current_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "previous_version", resourceName, "current_version"),
+					resource.TestCheckResourceAttr(resourceName, "test_upgrade", "false"),
+					resource.TestCheckResourceAttr(resourceName, "distribute", "false"),
+					resource.TestCheckResourceAttr(resourceName, "upgrade", "false"),
+					resource.TestCheckResourceAttr(resourceName, "revert_on_destroy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "wait_timeout", "3600"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridUpgradeBasicConfig(true, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "revert_on_destroy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "wait_timeout", "7200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridUpgradeResource_UpgradeGroups(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Upgrade groups without upgrade
+			{
+				Config:      testAccGridUpgradeUpgradeGroups(),
+				ExpectError: regexp.MustCompile(`upgrade_groups can only be set when upgrade is set to true`),
+			},
+		},
+	})
+}
+
+func testAccGridUpgradeBasicConfig(revertOnDestroy bool, waitTimeout int) string {
+	return fmt.Sprintf(`
+resource "nios_grid_upgrade" "test" {
+  distribute        = false
+  revert_on_destroy = %t
+  wait_timeout      = %d
+}
+`, revertOnDestroy, waitTimeout)
+}
+
+func testAccGridUpgradeUpgradeGroups() string {
+	return `
+resource "nios_grid_upgrade" "test_upgrade_groups" {
+  distribute     = false
+  upgrade_groups = ["Grid Master"]
+}
+`
+}
@@ -0,0 +1,39 @@
+package grid_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// X509 Certificates: at least one certificate uploaded to the Grid
+
+func TestAccGridX509certificateDataSource_basic(t *testing.T) {
+	dataSourceName := "data.nios_grid_x509certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGridX509certificateDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.subject"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.valid_not_after"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccGridX509certificateDataSourceConfig() string {
+	return `
+data "nios_grid_x509certificate" "test" {}
+`
+}
@@ -0,0 +1,41 @@
+package grid_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// - At least one Grid-wide license installed on the Grid
+
+func TestAccLicenseGridwideDataSource_Read(t *testing.T) {
+	dataSourceName := "data.nios_grid_license_gridwide.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseGridwideDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.expiration_status"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccLicenseGridwideDataSourceConfig() string {
+	return `
+data "nios_grid_license_gridwide" "test" {}
+`
+}
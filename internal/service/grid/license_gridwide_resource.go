@@ -0,0 +1,221 @@
+package grid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForLicenseGridwide = "expiration_status,expiry_date,key,limit,limit_context,type"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LicenseGridwideResource{}
+var _ resource.ResourceWithImportState = &LicenseGridwideResource{}
+
+func NewLicenseGridwideResource() resource.Resource {
+	return &LicenseGridwideResource{}
+}
+
+// LicenseGridwideResource defines the resource implementation.
+type LicenseGridwideResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *LicenseGridwideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "grid_license_gridwide"
+}
+
+func (r *LicenseGridwideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Grid-wide License installed from a license string.",
+		Attributes:          LicenseGridwideResourceSchemaAttributes,
+	}
+}
+
+func (r *LicenseGridwideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LicenseGridwideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LicenseGridwideModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := installLicense(ctx, r.client, data.Key.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to install Grid-wide License, got error: %s", err))
+		return
+	}
+
+	apiRes, _, err := r.client.GridAPI.
+		LicenseGridwideAPI.
+		List(ctx).
+		ReturnFieldsPlus(readableAttributesForLicenseGridwide).
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Grid-wide License, got error: %s", err))
+		return
+	}
+
+	var res *grid.LicenseGridwide
+	for _, license := range apiRes.ListLicenseGridwideResponseObject.GetResult() {
+		if isSameLicenseKey(license.GetKey(), data.Key.ValueString()) {
+			res = &license
+			break
+		}
+	}
+	if res == nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			"The license was uploaded but is not installed on the Grid. Make sure the license is a Grid-wide license issued for this Grid.",
+		)
+		return
+	}
+
+	key := data.Key
+	data.Flatten(ctx, res, &resp.Diagnostics)
+	data.Key = key
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LicenseGridwideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LicenseGridwideModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *grid.GetLicenseGridwideResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			LicenseGridwideAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForLicenseGridwide).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Grid-wide License, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetLicenseGridwideResponseObjectAsResult.GetResult()
+
+	key := data.Key
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+	if isSameLicenseKey(res.GetKey(), key.ValueString()) {
+		data.Key = key
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LicenseGridwideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LicenseGridwideModel
+
+	// The license string cannot be changed in place, only computed attributes are refreshed
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LicenseGridwideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LicenseGridwideModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		httpRes, callErr := r.client.GridAPI.
+			LicenseGridwideAPI.
+			Delete(ctx, resourceRef).
+			Execute()
+
+		if httpRes != nil {
+			if httpRes.StatusCode == http.StatusNotFound {
+				return 0, nil
+			}
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Grid-wide License, got error: %s", err))
+		return
+	}
+}
+
+func (r *LicenseGridwideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
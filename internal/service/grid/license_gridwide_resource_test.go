@@ -0,0 +1,175 @@
+package grid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForLicenseGridwide = "expiration_status,expiry_date,key,limit,limit_context,type"
+
+func TestAccLicenseGridwideResource_basic(t *testing.T) {
+	key := utils.GetNIOSGridwideLicenseKey()
+	if key == "" {
+		t.Skip("Skipping test: NIOS_GRIDWIDE_LICENSE_KEY must be set to a Grid-wide license issued for the Grid")
+	}
+	var resourceName = "nios_grid_license_gridwide.test"
+	var v grid.LicenseGridwide
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccLicenseGridwideBasicConfig(key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLicenseGridwideExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "key", key),
+					resource.TestCheckResourceAttrSet(resourceName, "type"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_status"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccLicenseGridwideResource_disappears(t *testing.T) {
+	key := utils.GetNIOSGridwideLicenseKey()
+	if key == "" {
+		t.Skip("Skipping test: NIOS_GRIDWIDE_LICENSE_KEY must be set to a Grid-wide license issued for the Grid")
+	}
+	resourceName := "nios_grid_license_gridwide.test"
+	var v grid.LicenseGridwide
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLicenseGridwideDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseGridwideBasicConfig(key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLicenseGridwideExists(context.Background(), resourceName, &v),
+					testAccCheckLicenseGridwideDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLicenseGridwideResource_Import(t *testing.T) {
+	key := utils.GetNIOSGridwideLicenseKey()
+	if key == "" {
+		t.Skip("Skipping test: NIOS_GRIDWIDE_LICENSE_KEY must be set to a Grid-wide license issued for the Grid")
+	}
+	var resourceName = "nios_grid_license_gridwide.test"
+	var v grid.LicenseGridwide
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseGridwideBasicConfig(key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLicenseGridwideExists(context.Background(), resourceName, &v),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccLicenseGridwideImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func testAccCheckLicenseGridwideExists(ctx context.Context, resourceName string, v *grid.LicenseGridwide) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.GridAPI.
+			LicenseGridwideAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForLicenseGridwide).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetLicenseGridwideResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetLicenseGridwideResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckLicenseGridwideDestroy(ctx context.Context, v *grid.LicenseGridwide) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.GridAPI.
+			LicenseGridwideAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForLicenseGridwide).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckLicenseGridwideDisappears(ctx context.Context, v *grid.LicenseGridwide) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.GridAPI.
+			LicenseGridwideAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccLicenseGridwideImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccLicenseGridwideBasicConfig(key string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_license_gridwide" "test" {
+	key = %q
+}
+`, key)
+}
@@ -0,0 +1,45 @@
+package grid_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// - NIOS license installed on the Grid Master
+
+func TestAccMemberLicenseDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_grid_member_license.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberLicenseDataSourceConfigFilters(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.type", "NIOS"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.hwid"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.expiration_status"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccMemberLicenseDataSourceConfigFilters() string {
+	return `
+data "nios_grid_member_license" "test" {
+  filters = {
+	type = "NIOS"
+  }
+}
+`
+}
@@ -0,0 +1,260 @@
+package grid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForMemberLicense = "expiration_status,expiry_date,hwid,key,kind,limit,limit_context,type"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MemberLicenseResource{}
+var _ resource.ResourceWithImportState = &MemberLicenseResource{}
+
+func NewMemberLicenseResource() resource.Resource {
+	return &MemberLicenseResource{}
+}
+
+// MemberLicenseResource defines the resource implementation.
+type MemberLicenseResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *MemberLicenseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "grid_member_license"
+}
+
+func (r *MemberLicenseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Member License installed from a license string.",
+		Attributes:          MemberLicenseResourceSchemaAttributes,
+	}
+}
+
+func (r *MemberLicenseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MemberLicenseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MemberLicenseModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := installLicense(ctx, r.client, data.Key.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to install Member License, got error: %s", err))
+		return
+	}
+
+	apiRes, _, err := r.client.GridAPI.
+		MemberLicenseAPI.
+		List(ctx).
+		ReturnFieldsPlus(readableAttributesForMemberLicense).
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Member License, got error: %s", err))
+		return
+	}
+
+	var res *grid.MemberLicense
+	for _, license := range apiRes.ListMemberLicenseResponseObject.GetResult() {
+		if isSameLicenseKey(license.GetKey(), data.Key.ValueString()) {
+			res = &license
+			break
+		}
+	}
+	if res == nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			"The license was uploaded but is not installed on any member. Make sure the license was issued for the hardware ID of a member of this Grid.",
+		)
+		return
+	}
+
+	key := data.Key
+	data.Flatten(ctx, res, &resp.Diagnostics)
+	data.Key = key
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberLicenseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MemberLicenseModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *grid.GetMemberLicenseResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			MemberLicenseAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForMemberLicense).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Member License, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetMemberLicenseResponseObjectAsResult.GetResult()
+
+	key := data.Key
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+	if isSameLicenseKey(res.GetKey(), key.ValueString()) {
+		data.Key = key
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberLicenseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MemberLicenseModel
+
+	// The license string cannot be changed in place, only computed attributes are refreshed
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberLicenseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MemberLicenseModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		httpRes, callErr := r.client.GridAPI.
+			MemberLicenseAPI.
+			Delete(ctx, resourceRef).
+			Execute()
+
+		if httpRes != nil {
+			if httpRes.StatusCode == http.StatusNotFound {
+				return 0, nil
+			}
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Member License, got error: %s", err))
+		return
+	}
+}
+
+func (r *MemberLicenseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
+
+// installLicense uploads the license string as a license file and installs it with the fileop update_licenses function.
+// NIOS installs each license on the member or Grid it was issued for.
+func installLicense(ctx context.Context, client *niosclient.APIClient, key string) error {
+	baseUrl := client.GridAPI.Cfg.NIOSHostURL
+	username := client.GridAPI.Cfg.NIOSUsername
+	password := client.GridAPI.Cfg.NIOSPassword
+
+	licenseFile, err := os.CreateTemp("", "nios-license-*.txt")
+	if err != nil {
+		return fmt.Errorf("unable to create license file: %w", err)
+	}
+	defer func() { _ = os.Remove(licenseFile.Name()) }()
+
+	_, err = licenseFile.WriteString(strings.TrimSpace(key) + "\n")
+	if closeErr := licenseFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write license file: %w", err)
+	}
+
+	token, err := utils.UploadFileWithToken(ctx, baseUrl, licenseFile.Name(), username, password)
+	if err != nil {
+		return err
+	}
+
+	_, err = utils.CallWAPIFunction(ctx, baseUrl, username, password, "fileop", "update_licenses", map[string]any{
+		"token": token,
+	})
+	return err
+}
+
+// isSameLicenseKey compares license strings, ignoring surrounding whitespace.
+func isSameLicenseKey(a, b string) bool {
+	return strings.TrimSpace(a) == strings.TrimSpace(b)
+}
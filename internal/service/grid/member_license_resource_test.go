@@ -0,0 +1,176 @@
+package grid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForMemberLicense = "expiration_status,expiry_date,hwid,key,kind,limit,limit_context,type"
+
+func TestAccMemberLicenseResource_basic(t *testing.T) {
+	key := utils.GetNIOSMemberLicenseKey()
+	if key == "" {
+		t.Skip("Skipping test: NIOS_MEMBER_LICENSE_KEY must be set to a license issued for a member of the Grid")
+	}
+	var resourceName = "nios_grid_member_license.test"
+	var v grid.MemberLicense
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberLicenseBasicConfig(key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberLicenseExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "key", key),
+					resource.TestCheckResourceAttrSet(resourceName, "type"),
+					resource.TestCheckResourceAttrSet(resourceName, "hwid"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_status"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMemberLicenseResource_disappears(t *testing.T) {
+	key := utils.GetNIOSMemberLicenseKey()
+	if key == "" {
+		t.Skip("Skipping test: NIOS_MEMBER_LICENSE_KEY must be set to a license issued for a member of the Grid")
+	}
+	resourceName := "nios_grid_member_license.test"
+	var v grid.MemberLicense
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMemberLicenseDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberLicenseBasicConfig(key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberLicenseExists(context.Background(), resourceName, &v),
+					testAccCheckMemberLicenseDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMemberLicenseResource_Import(t *testing.T) {
+	key := utils.GetNIOSMemberLicenseKey()
+	if key == "" {
+		t.Skip("Skipping test: NIOS_MEMBER_LICENSE_KEY must be set to a license issued for a member of the Grid")
+	}
+	var resourceName = "nios_grid_member_license.test"
+	var v grid.MemberLicense
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberLicenseBasicConfig(key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberLicenseExists(context.Background(), resourceName, &v),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccMemberLicenseImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func testAccCheckMemberLicenseExists(ctx context.Context, resourceName string, v *grid.MemberLicense) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.GridAPI.
+			MemberLicenseAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForMemberLicense).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetMemberLicenseResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetMemberLicenseResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckMemberLicenseDestroy(ctx context.Context, v *grid.MemberLicense) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.GridAPI.
+			MemberLicenseAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForMemberLicense).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckMemberLicenseDisappears(ctx context.Context, v *grid.MemberLicense) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.GridAPI.
+			MemberLicenseAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccMemberLicenseImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccMemberLicenseBasicConfig(key string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_license" "test" {
+	key = %q
+}
+`, key)
+}
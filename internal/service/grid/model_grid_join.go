@@ -3,10 +3,15 @@ package grid
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -23,15 +28,25 @@ type GridJoinModel struct {
 	GridName       types.String `tfsdk:"grid_name"`
 	Master         types.String `tfsdk:"master"`
 	SharedSecret   types.String `tfsdk:"shared_secret"`
+	MemberHostName types.String `tfsdk:"member_host_name"`
+	JoinTimeout    types.Int64  `tfsdk:"join_timeout"`
+	LeaveOnDestroy types.Bool   `tfsdk:"leave_on_destroy"`
+	MemberRef      types.String `tfsdk:"member_ref"`
+	MemberStatus   types.String `tfsdk:"member_status"`
 }
 
 var GridJoinAttrTypes = map[string]attr.Type{
-	"member_username": types.StringType,
-	"member_password": types.StringType,
-	"member_url":      types.StringType,
-	"grid_name":       types.StringType,
-	"master":          types.StringType,
-	"shared_secret":   types.StringType,
+	"member_username":  types.StringType,
+	"member_password":  types.StringType,
+	"member_url":       types.StringType,
+	"grid_name":        types.StringType,
+	"master":           types.StringType,
+	"shared_secret":    types.StringType,
+	"member_host_name": types.StringType,
+	"join_timeout":     types.Int64Type,
+	"leave_on_destroy": types.BoolType,
+	"member_ref":       types.StringType,
+	"member_status":    types.StringType,
 }
 
 var GridJoinResourceSchemaAttributes = map[string]schema.Attribute{
@@ -79,6 +94,36 @@ var GridJoinResourceSchemaAttributes = map[string]schema.Attribute{
 		},
 		MarkdownDescription: "The shared secret string of the grid.",
 	},
+	"member_host_name": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The host name of the member on the grid master. Used to locate the member while verifying the join. If not set, the member is located by matching the host of `member_url` against its VIP, IPv6 or HA node management addresses.",
+	},
+	"join_timeout": schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(1800),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(60),
+		},
+		MarkdownDescription: "The maximum time in seconds to wait for the member to come online on the grid master after the join is initiated. Defaults to 1800.",
+	},
+	"leave_on_destroy": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Removes the member from the grid when the resource is destroyed. By default, destroying the resource only removes it from the Terraform state.",
+	},
+	"member_ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the member object on the grid master.",
+	},
+	"member_status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The node status of the member as reported by the grid master.",
+	},
 }
 
 func ExpandGridJoin(ctx context.Context, o types.Object, diags *diag.Diagnostics) *grid.GridJoin {
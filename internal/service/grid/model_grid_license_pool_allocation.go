@@ -0,0 +1,67 @@
+package grid
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GridLicensePoolAllocationModel struct {
+	Member            types.String `tfsdk:"member"`
+	Licenses          types.List   `tfsdk:"licenses"`
+	WaitTimeout       types.Int64  `tfsdk:"wait_timeout"`
+	AllocatedLicenses types.List   `tfsdk:"allocated_licenses"`
+}
+
+var GridLicensePoolAllocationAttrTypes = map[string]attr.Type{
+	"member":             types.StringType,
+	"licenses":           types.ListType{ElemType: types.StringType},
+	"wait_timeout":       types.Int64Type,
+	"allocated_licenses": types.ListType{ElemType: types.ObjectType{AttrTypes: MemberLicenseAttrTypes}},
+}
+
+var GridLicensePoolAllocationResourceSchemaAttributes = map[string]schema.Attribute{
+	"member": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The host name of the vNIOS member to allocate licenses to.",
+	},
+	"licenses": schema.ListAttribute{
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+		MarkdownDescription: "The license types to allocate from the license pools, for example `NIOS`, `DNS` and `DHCP`. Removing a license type returns the license to its pool.",
+	},
+	"wait_timeout": schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(600),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(30),
+		},
+		MarkdownDescription: "The maximum time in seconds to wait for the allocated licenses to be installed on the member. Defaults to 600.",
+	},
+	"allocated_licenses": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: MemberLicenseResourceSchemaAttributes,
+		},
+		Computed:            true,
+		MarkdownDescription: "The licenses installed on the member for the requested license types.",
+	},
+}
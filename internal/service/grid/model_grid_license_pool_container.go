@@ -0,0 +1,64 @@
+package grid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type GridLicensePoolContainerModel struct {
+	Ref                   types.String `tfsdk:"ref"`
+	LastEntitlementUpdate types.Int64  `tfsdk:"last_entitlement_update"`
+	LpcUid                types.String `tfsdk:"lpc_uid"`
+}
+
+var GridLicensePoolContainerAttrTypes = map[string]attr.Type{
+	"ref":                     types.StringType,
+	"last_entitlement_update": types.Int64Type,
+	"lpc_uid":                 types.StringType,
+}
+
+var GridLicensePoolContainerResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"last_entitlement_update": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The timestamp when the last pool licenses were updated.",
+	},
+	"lpc_uid": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The world-wide unique ID for the license pool container.",
+	},
+}
+
+func FlattenGridLicensePoolContainer(ctx context.Context, from *grid.GridLicensePoolContainer, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(GridLicensePoolContainerAttrTypes)
+	}
+	m := GridLicensePoolContainerModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, GridLicensePoolContainerAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *GridLicensePoolContainerModel) Flatten(ctx context.Context, from *grid.GridLicensePoolContainer, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = GridLicensePoolContainerModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.LastEntitlementUpdate = flex.FlattenInt64Pointer(from.LastEntitlementUpdate)
+	m.LpcUid = flex.FlattenStringPointer(from.LpcUid)
+}
@@ -0,0 +1,142 @@
+package grid
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/planmodifiers/immutable"
+)
+
+type GridUpgradeModel struct {
+	ImageFilePath       types.String `tfsdk:"image_file_path"`
+	TestUpgrade         types.Bool   `tfsdk:"test_upgrade"`
+	Distribute          types.Bool   `tfsdk:"distribute"`
+	Upgrade             types.Bool   `tfsdk:"upgrade"`
+	UpgradeGroups       types.List   `tfsdk:"upgrade_groups"`
+	RevertOnDestroy     types.Bool   `tfsdk:"revert_on_destroy"`
+	WaitTimeout         types.Int64  `tfsdk:"wait_timeout"`
+	TargetVersion       types.String `tfsdk:"target_version"`
+	PreviousVersion     types.String `tfsdk:"previous_version"`
+	CurrentVersion      types.String `tfsdk:"current_version"`
+	DistributionVersion types.String `tfsdk:"distribution_version"`
+	UpgradeTestStatus   types.String `tfsdk:"upgrade_test_status"`
+	MemberStatus        types.List   `tfsdk:"member_status"`
+}
+
+var GridUpgradeAttrTypes = map[string]attr.Type{
+	"image_file_path":      types.StringType,
+	"test_upgrade":         types.BoolType,
+	"distribute":           types.BoolType,
+	"upgrade":              types.BoolType,
+	"upgrade_groups":       types.ListType{ElemType: types.StringType},
+	"revert_on_destroy":    types.BoolType,
+	"wait_timeout":         types.Int64Type,
+	"target_version":       types.StringType,
+	"previous_version":     types.StringType,
+	"current_version":      types.StringType,
+	"distribution_version": types.StringType,
+	"upgrade_test_status":  types.StringType,
+	"member_status":        types.ListType{ElemType: types.ObjectType{AttrTypes: UpgradestatusAttrTypes}},
+}
+
+var GridUpgradeResourceSchemaAttributes = map[string]schema.Attribute{
+	"image_file_path": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The local path of the NIOS upgrade image to upload. If not set, the image that is already uploaded to the Grid is used. Changing it to a new image runs all configured phases again with the new image, without replacing the resource.",
+	},
+	"test_upgrade": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		PlanModifiers: []planmodifier.Bool{
+			immutable.ImmutableBool(),
+		},
+		MarkdownDescription: "Runs a test upgrade with the uploaded image before distribution. A failed test upgrade fails the apply.",
+	},
+	"distribute": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(true),
+		PlanModifiers: []planmodifier.Bool{
+			immutable.ImmutableBool(),
+		},
+		MarkdownDescription: "Starts the distribution of the uploaded image and waits until all members have received it.",
+	},
+	"upgrade": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		PlanModifiers: []planmodifier.Bool{
+			immutable.ImmutableBool(),
+		},
+		MarkdownDescription: "Upgrades the Grid to the distributed image and waits until the members run the target version.",
+	},
+	"upgrade_groups": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+		},
+		PlanModifiers: []planmodifier.List{
+			immutable.ImmutableList(),
+		},
+		MarkdownDescription: "The upgrade groups to upgrade, in order. The Grid Master is upgraded first, then each group is upgraded and verified before the next one starts. If not set, the whole Grid is upgraded at once. Requires `upgrade` to be set to true.",
+	},
+	"revert_on_destroy": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Reverts the Grid to the previous version when the resource is destroyed. By default, destroying the resource only removes it from the Terraform state.",
+	},
+	"wait_timeout": schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(7200),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(60),
+		},
+		MarkdownDescription: "The maximum time in seconds to wait for each phase (upload, test upgrade, distribution, upgrade of each group and revert) to complete. Defaults to 7200.",
+	},
+	"target_version": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The version of the uploaded upgrade image.",
+	},
+	"previous_version": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The version the Grid was running before the upgrade. Used to verify a revert.",
+	},
+	"current_version": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The version the Grid is currently running.",
+	},
+	"distribution_version": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The version that is currently distributed to the Grid.",
+	},
+	"upgrade_test_status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The status of the last test upgrade.",
+	},
+	"member_status": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: UpgradestatusResourceSchemaAttributes,
+		},
+		Computed:            true,
+		MarkdownDescription: "The upgrade status of each member of the Grid.",
+	},
+}
@@ -0,0 +1,100 @@
+package grid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type LicenseGridwideModel struct {
+	Ref              types.String `tfsdk:"ref"`
+	ExpirationStatus types.String `tfsdk:"expiration_status"`
+	ExpiryDate       types.Int64  `tfsdk:"expiry_date"`
+	Key              types.String `tfsdk:"key"`
+	Limit            types.String `tfsdk:"limit"`
+	LimitContext     types.String `tfsdk:"limit_context"`
+	Type             types.String `tfsdk:"type"`
+}
+
+var LicenseGridwideAttrTypes = map[string]attr.Type{
+	"ref":               types.StringType,
+	"expiration_status": types.StringType,
+	"expiry_date":       types.Int64Type,
+	"key":               types.StringType,
+	"limit":             types.StringType,
+	"limit_context":     types.StringType,
+	"type":              types.StringType,
+}
+
+var LicenseGridwideResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"expiration_status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The license expiration status. * DELETED - The temporary license has been deleted. * EXPIRED - License has reached the expiry date. * EXPIRING_SOON - License expires in 31-90 days. * EXPIRING_VERY_SOON - License expires in 30 days or earlier. * NOT_EXPIRED - License has not expired. * PERMANENT - License does not expire.",
+	},
+	"expiry_date": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The expiration timestamp of the license, in seconds since the Unix epoch.",
+	},
+	"key": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The Grid-wide license string to install.",
+	},
+	"limit": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The license limit value.",
+	},
+	"limit_context": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The license limit context.",
+	},
+	"type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The license type.",
+	},
+}
+
+func FlattenLicenseGridwide(ctx context.Context, from *grid.LicenseGridwide, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(LicenseGridwideAttrTypes)
+	}
+	m := LicenseGridwideModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, LicenseGridwideAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *LicenseGridwideModel) Flatten(ctx context.Context, from *grid.LicenseGridwide, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = LicenseGridwideModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.ExpirationStatus = flex.FlattenStringPointer(from.ExpirationStatus)
+	m.ExpiryDate = flex.FlattenInt64Pointer(from.ExpiryDate)
+	m.Key = flex.FlattenStringPointer(from.Key)
+	m.Limit = flex.FlattenStringPointer(from.Limit)
+	m.LimitContext = flex.FlattenStringPointer(from.LimitContext)
+	m.Type = flex.FlattenStringPointer(from.Type)
+}
@@ -0,0 +1,57 @@
+package grid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type UpgradestatusHotfixesModel struct {
+	StatusText types.String `tfsdk:"status_text"`
+	UniqueId   types.String `tfsdk:"unique_id"`
+}
+
+var UpgradestatusHotfixesAttrTypes = map[string]attr.Type{
+	"status_text": types.StringType,
+	"unique_id":   types.StringType,
+}
+
+var UpgradestatusHotfixesResourceSchemaAttributes = map[string]schema.Attribute{
+	"status_text": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The status text of the hotfix.",
+	},
+	"unique_id": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Unique ID of the hotfix.",
+	},
+}
+
+func FlattenUpgradestatusHotfixes(ctx context.Context, from *grid.UpgradestatusHotfixes, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(UpgradestatusHotfixesAttrTypes)
+	}
+	m := UpgradestatusHotfixesModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, UpgradestatusHotfixesAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *UpgradestatusHotfixesModel) Flatten(ctx context.Context, from *grid.UpgradestatusHotfixes, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = UpgradestatusHotfixesModel{}
+	}
+	m.StatusText = flex.FlattenStringPointer(from.StatusText)
+	m.UniqueId = flex.FlattenStringPointer(from.UniqueId)
+}
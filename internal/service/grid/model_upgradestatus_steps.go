@@ -0,0 +1,57 @@
+package grid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type UpgradestatusStepsModel struct {
+	StatusValue types.String `tfsdk:"status_value"`
+	StatusText  types.String `tfsdk:"status_text"`
+}
+
+var UpgradestatusStepsAttrTypes = map[string]attr.Type{
+	"status_value": types.StringType,
+	"status_text":  types.StringType,
+}
+
+var UpgradestatusStepsResourceSchemaAttributes = map[string]schema.Attribute{
+	"status_value": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The status value of a step.",
+	},
+	"status_text": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The status text that describes a step.",
+	},
+}
+
+func FlattenUpgradestatusSteps(ctx context.Context, from *grid.UpgradestatusSteps, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(UpgradestatusStepsAttrTypes)
+	}
+	m := UpgradestatusStepsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, UpgradestatusStepsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *UpgradestatusStepsModel) Flatten(ctx context.Context, from *grid.UpgradestatusSteps, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = UpgradestatusStepsModel{}
+	}
+	m.StatusValue = flex.FlattenStringPointer(from.StatusValue)
+	m.StatusText = flex.FlattenStringPointer(from.StatusText)
+}
@@ -0,0 +1,50 @@
+package grid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccUpgradestatusDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_grid_upgradestatus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpgradestatusDataSourceConfigFilters("GRID"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.type", "GRID"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.current_version"),
+				),
+			},
+			{
+				Config: testAccUpgradestatusDataSourceConfigFilters("MEMBER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.type", "MEMBER"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.member"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccUpgradestatusDataSourceConfigFilters(statusType string) string {
+	return fmt.Sprintf(`
+data "nios_grid_upgradestatus" "test" {
+  filters = {
+	type = %q
+  }
+}
+`, statusType)
+}
@@ -0,0 +1,123 @@
+package ipam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccBulkhostDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_ipam_bulkhost.test"
+	resourceName := "nios_ipam_bulkhost.test"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBulkhostDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBulkhostDataSourceConfigFilters(prefix, "192.168.10.101", "192.168.10.105", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					}, testAccCheckBulkhostResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccBulkhostDataSource_ExtAttrFilters(t *testing.T) {
+	dataSourceName := "data.nios_ipam_bulkhost.test"
+	resourceName := "nios_ipam_bulkhost.test"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBulkhostDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBulkhostDataSourceConfigExtAttrFilters(prefix, "192.168.10.106", "192.168.10.110", "example.com", acctest.RandomName()),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					}, testAccCheckBulkhostResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckBulkhostResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "cloud_info", dataSourceName, "result.0.cloud_info"),
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "dns_prefix", dataSourceName, "result.0.dns_prefix"),
+		resource.TestCheckResourceAttrPair(resourceName, "end_addr", dataSourceName, "result.0.end_addr"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "last_queried", dataSourceName, "result.0.last_queried"),
+		resource.TestCheckResourceAttrPair(resourceName, "name_template", dataSourceName, "result.0.name_template"),
+		resource.TestCheckResourceAttrPair(resourceName, "network_view", dataSourceName, "result.0.network_view"),
+		resource.TestCheckResourceAttrPair(resourceName, "policy", dataSourceName, "result.0.policy"),
+		resource.TestCheckResourceAttrPair(resourceName, "prefix", dataSourceName, "result.0.prefix"),
+		resource.TestCheckResourceAttrPair(resourceName, "reverse", dataSourceName, "result.0.reverse"),
+		resource.TestCheckResourceAttrPair(resourceName, "start_addr", dataSourceName, "result.0.start_addr"),
+		resource.TestCheckResourceAttrPair(resourceName, "template_format", dataSourceName, "result.0.template_format"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_name_template", dataSourceName, "result.0.use_name_template"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+		resource.TestCheckResourceAttrPair(resourceName, "zone", dataSourceName, "result.0.zone"),
+	}
+}
+
+func testAccBulkhostDataSourceConfigFilters(prefix, startAddr, endAddr, zone string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_bulkhost" "test" {
+  prefix     = %q
+  start_addr = %q
+  end_addr   = %q
+  zone       = %q
+}
+
+data "nios_ipam_bulkhost" "test" {
+  filters = {
+	prefix = nios_ipam_bulkhost.test.prefix
+  }
+}
+`, prefix, startAddr, endAddr, zone)
+}
+
+func testAccBulkhostDataSourceConfigExtAttrFilters(prefix, startAddr, endAddr, zone, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_bulkhost" "test" {
+  prefix     = %q
+  start_addr = %q
+  end_addr   = %q
+  zone       = %q
+  extattrs = {
+    Site = %q
+  }
+}
+
+data "nios_ipam_bulkhost" "test" {
+  extattrfilters = {
+	Site = nios_ipam_bulkhost.test.extattrs.Site
+  }
+}
+`, prefix, startAddr, endAddr, zone, extAttrsValue)
+}
@@ -0,0 +1,196 @@
+package ipam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &BulkhostList{}
+var _ list.ListResourceWithConfigure = &BulkhostList{}
+
+func NewBulkhostList() list.ListResource {
+	return &BulkhostList{}
+}
+
+// BulkhostList defines the List implementation.
+type BulkhostList struct {
+	client *niosclient.APIClient
+}
+
+func (l *BulkhostList) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "ipam_bulkhost"
+}
+
+func (l *BulkhostList) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	l.client = client
+}
+
+type BulkhostListModel struct {
+	Filters        types.Map `tfsdk:"filters"`
+	ExtAttrFilters types.Map `tfsdk:"extattrfilters"`
+}
+
+func (l *BulkhostList) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Query existing IPAM Bulk Hosts.",
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.MapAttribute{
+				MarkdownDescription: "Filters are used to return a more specific list of results. Filters can be used to match resources by specific attributes, e.g. name. If you specify multiple filters, the results returned will have only resources that match all the specified filters.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"extattrfilters": schema.MapAttribute{
+				MarkdownDescription: "External Attribute Filters are used to return a more specific list of results by filtering on external attributes. If you specify multiple filters, the results returned will have only resources that match all the specified filters.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (l *BulkhostList) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data BulkhostListModel
+	pageCount := 0
+	// Default Limit is 100
+	limit := int32(req.Limit)
+	var totalFetched int32
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	allResults, err := utils.ReadWithPages(
+		func(pageID string, maxResultsPerPage int32) ([]ipam.Bulkhost, string, error) {
+
+			var paging int32 = 1
+
+			// Adjust page size to not fetch more than the remaining needed results.
+			if remaining := limit - totalFetched; remaining < maxResultsPerPage {
+				maxResultsPerPage = remaining
+			}
+
+			//Increment the page count
+			pageCount++
+
+			request := l.client.IPAMAPI.
+				BulkhostAPI.
+				List(ctx).
+				Filters(flex.ExpandFrameworkMapString(ctx, data.Filters, &diags)).
+				Extattrfilter(flex.ExpandFrameworkMapString(ctx, data.ExtAttrFilters, &diags)).
+				ReturnAsObject(1).
+				ReturnFieldsPlus(readableAttributesForBulkhost).
+				Paging(paging).
+				MaxResults(maxResultsPerPage)
+
+			// Add page ID if provided
+			if pageID != "" {
+				request = request.PageId(pageID)
+			}
+
+			// Execute the request
+			apiRes, _, err := request.Execute()
+			if err != nil {
+				return nil, "", err
+			}
+
+			res := apiRes.ListBulkhostResponseObject.GetResult()
+			tflog.Info(ctx, fmt.Sprintf("Page %d : Retrieved %d results", pageCount, len(res)))
+
+			totalFetched += int32(len(res))
+
+			// Check for next page ID in additional properties
+			additionalProperties := apiRes.ListBulkhostResponseObject.AdditionalProperties
+			var nextPageID string
+
+			// If the cumulative limit is reached, stop pagination.
+			if totalFetched >= limit {
+				tflog.Info(ctx, "Limit reached, stopped fetching more pages.")
+				return res, "", nil
+			}
+
+			npId, ok := additionalProperties["next_page_id"]
+			if ok {
+				if npIdStr, ok := npId.(string); ok {
+					nextPageID = npIdStr
+				}
+			} else {
+				tflog.Info(ctx, "No next page ID found. This is the last page.")
+			}
+			return res, nextPageID, nil
+		},
+	)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list Bulkhost, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range allResults {
+			result := req.NewListResult(ctx)
+
+			// Set the Identity for each result
+			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("ref"), &item.Ref)...)
+			if result.Diagnostics.HasError() {
+				if !push(result) {
+					return
+				}
+				continue
+			}
+
+			// By default, list only returns the identity.
+			// If IncludeResource is true, it gets the full resource and sets it in the result.Resource
+			if req.IncludeResource {
+
+				if item.ExtAttrs != nil {
+					delete(*item.ExtAttrs, terraformInternalIDEA)
+				}
+				result1 := FlattenBulkhost(ctx, &item, &result.Diagnostics)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &result1)...)
+				if result.Diagnostics.HasError() {
+					if !push(result) {
+						return
+					}
+					continue
+				}
+			}
+
+			// Push the result to the stream
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package ipam_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccBulkhostList_basic(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Config:                   testAccBulkhostBasicConfig(prefix, "192.168.10.111", "192.168.10.115", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "prefix", prefix),
+				),
+			},
+			// Query the object
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Query:                    true,
+				Config:                   testAccBulkhostListBasicConfig(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("nios_ipam_bulkhost.test", 1),
+				},
+			},
+		},
+	})
+}
+
+func TestAccBulkhostList_Filters(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Config:                   testAccBulkhostBasicConfig(prefix, "192.168.10.116", "192.168.10.120", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "prefix", prefix),
+				),
+			},
+			// Query the object
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Query:                    true,
+				Config:                   testAccBulkhostListConfigFilters(prefix),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("nios_ipam_bulkhost.test", 1),
+					querycheck.ExpectResourceKnownValues(
+						resourceName,
+						queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+							"ref": knownvalue.StringRegexp(regexp.MustCompile("bulkhost/")),
+						}),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("prefix"),
+								KnownValue: knownvalue.StringExact(prefix),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccBulkhostList_ExtAttrFilters(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test_extattrs"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	extAttrValue := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Config: testAccBulkhostExtAttrs(prefix, "192.168.10.121", "192.168.10.125", "example.com", map[string]string{
+					"Site": extAttrValue,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site", extAttrValue),
+				),
+			},
+			// Query the object
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Query:                    true,
+				Config:                   testAccBulkhostListConfigExtAttrFilters(extAttrValue),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("nios_ipam_bulkhost.test", 1),
+				},
+			},
+		},
+	})
+}
+
+func testAccBulkhostListBasicConfig() string {
+	return `
+list "nios_ipam_bulkhost" "test" {
+	provider = nios
+	limit = 5
+}
+`
+}
+
+func testAccBulkhostListConfigFilters(prefix string) string {
+	return fmt.Sprintf(`
+list "nios_ipam_bulkhost" "test" {
+	provider = nios
+	include_resource = true
+	config {
+		filters = {
+			prefix =  %q
+		}
+	}
+}
+`, prefix)
+}
+
+func testAccBulkhostListConfigExtAttrFilters(extAttrsValue string) string {
+	return fmt.Sprintf(`
+list "nios_ipam_bulkhost" "test" {
+	provider = nios
+	config {
+		extattrfilters = {
+			Site =  %q
+		}
+	}
+}
+`, extAttrsValue)
+}
@@ -0,0 +1,553 @@
+package ipam_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForBulkhost = "cloud_info,comment,disable,dns_prefix,end_addr,extattrs,last_queried,name_template,network_view,policy,prefix,reverse,start_addr,template_format,ttl,use_name_template,use_ttl,view,zone"
+
+func TestAccBulkhostResource_basic(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccBulkhostBasicConfig(prefix, "192.168.10.10", "192.168.10.20", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "prefix", prefix),
+					resource.TestCheckResourceAttr(resourceName, "start_addr", "192.168.10.10"),
+					resource.TestCheckResourceAttr(resourceName, "end_addr", "192.168.10.20"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "reverse", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_name_template", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBulkhostResource_disappears(t *testing.T) {
+	resourceName := "nios_ipam_bulkhost.test"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBulkhostDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBulkhostBasicConfig(prefix, "192.168.10.21", "192.168.10.25", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					testAccCheckBulkhostDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBulkhostResource_Import(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccBulkhostBasicConfig(prefix, "192.168.10.26", "192.168.10.30", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import with PlanOnly to detect differences
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccBulkhostImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+				PlanOnly:                             true,
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccBulkhostImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"extattrs_all"},
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBulkhostResource_Comment(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test_comment"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccBulkhostComment(prefix, "192.168.10.31", "192.168.10.35", "example.com", "Comment for the object"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Comment for the object"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccBulkhostComment(prefix, "192.168.10.31", "192.168.10.35", "example.com", "Updated comment for the object"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Updated comment for the object"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBulkhostResource_Disable(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test_disable"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccBulkhostDisable(prefix, "192.168.10.36", "192.168.10.40", "example.com", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccBulkhostDisable(prefix, "192.168.10.36", "192.168.10.40", "example.com", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBulkhostResource_Range(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccBulkhostBasicConfig(prefix, "192.168.10.41", "192.168.10.45", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "start_addr", "192.168.10.41"),
+					resource.TestCheckResourceAttr(resourceName, "end_addr", "192.168.10.45"),
+				),
+			},
+			// Grow the range in place
+			{
+				Config: testAccBulkhostBasicConfig(prefix, "192.168.10.41", "192.168.10.60", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "start_addr", "192.168.10.41"),
+					resource.TestCheckResourceAttr(resourceName, "end_addr", "192.168.10.60"),
+				),
+			},
+			// Shift the range in place
+			{
+				Config: testAccBulkhostBasicConfig(prefix, "192.168.10.50", "192.168.10.55", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "start_addr", "192.168.10.50"),
+					resource.TestCheckResourceAttr(resourceName, "end_addr", "192.168.10.55"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBulkhostResource_InvalidRange(t *testing.T) {
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBulkhostBasicConfig(prefix, "192.168.10.70", "192.168.10.61", "example.com"),
+				ExpectError: regexp.MustCompile("`start_addr` must be less than or equal to `end_addr`"),
+			},
+		},
+	})
+}
+
+func TestAccBulkhostResource_ExtAttrs(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test_extattrs"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccBulkhostExtAttrs(prefix, "192.168.10.71", "192.168.10.75", "example.com", map[string]string{
+					"Site": extAttrValue1,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccBulkhostExtAttrs(prefix, "192.168.10.71", "192.168.10.75", "example.com", map[string]string{
+					"Site": extAttrValue2,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBulkhostResource_NameTemplate(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test_name_template"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+	templateName := acctest.RandomNameWithPrefix("bulk-template")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccBulkhostNameTemplate(prefix, "192.168.10.76", "192.168.10.80", "example.com", templateName, "host-$3-$4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name_template", templateName),
+					resource.TestCheckResourceAttr(resourceName, "use_name_template", "true"),
+					resource.TestCheckResourceAttr(resourceName, "template_format", "host-$3-$4"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccBulkhostNameTemplate(prefix, "192.168.10.76", "192.168.10.80", "example.com", templateName, "node-$4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name_template", templateName),
+					resource.TestCheckResourceAttr(resourceName, "template_format", "node-$4"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBulkhostResource_Prefix(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+	prefixUpdated := acctest.RandomNameWithPrefix("bulk-updated")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccBulkhostBasicConfig(prefix, "192.168.10.81", "192.168.10.85", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "prefix", prefix),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccBulkhostBasicConfig(prefixUpdated, "192.168.10.81", "192.168.10.85", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "prefix", prefixUpdated),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBulkhostResource_Reverse(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test_reverse"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccBulkhostReverse(prefix, "192.168.10.86", "192.168.10.90", "example.com", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "reverse", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccBulkhostReverse(prefix, "192.168.10.86", "192.168.10.90", "example.com", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "reverse", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBulkhostResource_Ttl(t *testing.T) {
+	var resourceName = "nios_ipam_bulkhost.test_ttl"
+	var v ipam.Bulkhost
+	prefix := acctest.RandomNameWithPrefix("bulk")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccBulkhostTtl(prefix, "192.168.10.91", "192.168.10.95", "example.com", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccBulkhostTtl(prefix, "192.168.10.91", "192.168.10.95", "example.com", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBulkhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "7200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckBulkhostExists(ctx context.Context, resourceName string, v *ipam.Bulkhost) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.IPAMAPI.
+			BulkhostAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForBulkhost).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetBulkhostResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetBulkhostResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckBulkhostDestroy(ctx context.Context, v *ipam.Bulkhost) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.IPAMAPI.
+			BulkhostAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForBulkhost).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckBulkhostDisappears(ctx context.Context, v *ipam.Bulkhost) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.IPAMAPI.
+			BulkhostAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccBulkhostImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.Attributes["ref"] == "" {
+			return "", fmt.Errorf("ref is not set")
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccBulkhostBasicConfig(prefix, startAddr, endAddr, zone string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_bulkhost" "test" {
+    prefix     = %q
+    start_addr = %q
+    end_addr   = %q
+    zone       = %q
+}
+`, prefix, startAddr, endAddr, zone)
+}
+
+func testAccBulkhostComment(prefix, startAddr, endAddr, zone, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_bulkhost" "test_comment" {
+    prefix     = %q
+    start_addr = %q
+    end_addr   = %q
+    zone       = %q
+    comment    = %q
+}
+`, prefix, startAddr, endAddr, zone, comment)
+}
+
+func testAccBulkhostDisable(prefix, startAddr, endAddr, zone, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_bulkhost" "test_disable" {
+    prefix     = %q
+    start_addr = %q
+    end_addr   = %q
+    zone       = %q
+    disable    = %q
+}
+`, prefix, startAddr, endAddr, zone, disable)
+}
+
+func testAccBulkhostExtAttrs(prefix, startAddr, endAddr, zone string, extAttrs map[string]string) string {
+	extAttrsStr := "{\n"
+	for k, v := range extAttrs {
+		extAttrsStr += fmt.Sprintf("    %s = %q\n", k, v)
+	}
+	extAttrsStr += "  }"
+	return fmt.Sprintf(`
+resource "nios_ipam_bulkhost" "test_extattrs" {
+    prefix     = %q
+    start_addr = %q
+    end_addr   = %q
+    zone       = %q
+    extattrs   = %s
+}
+`, prefix, startAddr, endAddr, zone, extAttrsStr)
+}
+
+func testAccBulkhostNameTemplate(prefix, startAddr, endAddr, zone, templateName, templateFormat string) string {
+	config := fmt.Sprintf(`
+resource "nios_ipam_bulkhost" "test_name_template" {
+    prefix            = %q
+    start_addr        = %q
+    end_addr          = %q
+    zone              = %q
+    name_template     = nios_ipam_bulk_hostname_template.test.template_name
+    use_name_template = true
+}
+`, prefix, startAddr, endAddr, zone)
+	return strings.Join([]string{testAccBulkhostnametemplateBasicConfig(templateName, templateFormat), config}, "")
+}
+
+func testAccBulkhostReverse(prefix, startAddr, endAddr, zone, reverse string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_bulkhost" "test_reverse" {
+    prefix     = %q
+    start_addr = %q
+    end_addr   = %q
+    zone       = %q
+    reverse    = %q
+}
+`, prefix, startAddr, endAddr, zone, reverse)
+}
+
+func testAccBulkhostTtl(prefix, startAddr, endAddr, zone string, ttl int) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_bulkhost" "test_ttl" {
+    prefix     = %q
+    start_addr = %q
+    end_addr   = %q
+    zone       = %q
+    ttl        = %d
+    use_ttl    = true
+}
+`, prefix, startAddr, endAddr, zone, ttl)
+}
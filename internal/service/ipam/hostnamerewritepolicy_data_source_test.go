@@ -0,0 +1,43 @@
+package ipam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccHostnamerewritepolicyDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_ipam_hostnamerewritepolicy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostnamerewritepolicyDataSourceConfigFilters(hostnamerewritepolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", hostnamerewritepolicyName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.replacement_character"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.valid_characters"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccHostnamerewritepolicyDataSourceConfigFilters(name string) string {
+	return fmt.Sprintf(`
+data "nios_ipam_hostnamerewritepolicy" "test" {
+  filters = {
+    name = %q
+  }
+}
+`, name)
+}
@@ -0,0 +1,260 @@
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForHostnamerewritepolicy = "is_default,name,pre_defined,replacement_character,valid_characters"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HostnamerewritepolicyResource{}
+var _ resource.ResourceWithImportState = &HostnamerewritepolicyResource{}
+
+func NewHostnamerewritepolicyResource() resource.Resource {
+	return &HostnamerewritepolicyResource{}
+}
+
+// HostnamerewritepolicyResource defines the resource implementation.
+type HostnamerewritepolicyResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *HostnamerewritepolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "ipam_hostnamerewritepolicy"
+}
+
+func (r *HostnamerewritepolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the valid and replacement characters of an existing DHCP Hostname Rewrite Policy. " +
+			"Hostname rewrite policies cannot be created or deleted with WAPI, destroying the resource only removes it from the Terraform state.",
+		Attributes: HostnamerewritepolicyResourceSchemaAttributes,
+	}
+}
+
+func (r *HostnamerewritepolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HostnamerewritepolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HostnamerewritepolicyModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// WAPI does not support creating hostname rewrite policies, so the existing policy is looked up by name
+	listRes, _, err := r.client.IPAMAPI.
+		HostnamerewritepolicyAPI.
+		List(ctx).
+		Filters(map[string]interface{}{
+			"name": data.Name.ValueString(),
+		}).
+		ReturnFieldsPlus(readableAttributesForHostnamerewritepolicy).
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Hostnamerewritepolicy, got error: %s", err))
+		return
+	}
+
+	list := listRes.ListHostnamerewritepolicyResponseObject.GetResult()
+	if len(list) == 0 {
+		resp.Diagnostics.AddError(
+			"Not Found",
+			fmt.Sprintf("No hostname rewrite policy named %q exists. Hostname rewrite policies cannot be created with WAPI, add the policy on the Grid first.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *ipam.UpdateHostnamerewritepolicyResponse
+
+	err = retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.IPAMAPI.
+			HostnamerewritepolicyAPI.
+			Update(ctx, utils.ExtractResourceRef(list[0].GetRef())).
+			Hostnamerewritepolicy(*payload).
+			ReturnFieldsPlus(readableAttributesForHostnamerewritepolicy).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Hostnamerewritepolicy, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateHostnamerewritepolicyResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostnamerewritepolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HostnamerewritepolicyModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *ipam.GetHostnamerewritepolicyResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.IPAMAPI.
+			HostnamerewritepolicyAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForHostnamerewritepolicy).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Hostnamerewritepolicy, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetHostnamerewritepolicyResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostnamerewritepolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var diags diag.Diagnostics
+	var data HostnamerewritepolicyModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var apiRes *ipam.UpdateHostnamerewritepolicyResponse
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.IPAMAPI.
+			HostnamerewritepolicyAPI.
+			Update(ctx, resourceRef).
+			Hostnamerewritepolicy(*payload).
+			ReturnFieldsPlus(readableAttributesForHostnamerewritepolicy).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Hostnamerewritepolicy, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateHostnamerewritepolicyResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostnamerewritepolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// WAPI does not support deleting hostname rewrite policies, so the policy is only removed from state
+	tflog.Info(ctx, "Removing Hostnamerewritepolicy from state, the policy stays on the Grid")
+}
+
+func (r *HostnamerewritepolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,170 @@
+package ipam_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO: OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// A custom Hostname Rewrite Policy - example_hostname_rewrite_policy
+var readableAttributesForHostnamerewritepolicy = "is_default,name,pre_defined,replacement_character,valid_characters"
+
+const hostnamerewritepolicyName = "example_hostname_rewrite_policy"
+
+func TestAccHostnamerewritepolicyResource_basic(t *testing.T) {
+	var resourceName = "nios_ipam_hostnamerewritepolicy.test"
+	var v ipam.Hostnamerewritepolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHostnamerewritepolicyBasicConfig(hostnamerewritepolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostnamerewritepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", hostnamerewritepolicyName),
+					resource.TestCheckResourceAttr(resourceName, "pre_defined", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "replacement_character"),
+					resource.TestCheckResourceAttrSet(resourceName, "valid_characters"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHostnamerewritepolicyResource_NotFound(t *testing.T) {
+	name := acctest.RandomNameWithPrefix("hostname-rewrite-policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHostnamerewritepolicyBasicConfig(name),
+				ExpectError: regexp.MustCompile("No hostname rewrite policy named"),
+			},
+		},
+	})
+}
+
+func TestAccHostnamerewritepolicyResource_ReplacementCharacter(t *testing.T) {
+	var resourceName = "nios_ipam_hostnamerewritepolicy.test_replacement_character"
+	var v ipam.Hostnamerewritepolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHostnamerewritepolicyReplacementCharacter(hostnamerewritepolicyName, "-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostnamerewritepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "replacement_character", "-"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHostnamerewritepolicyReplacementCharacter(hostnamerewritepolicyName, "x"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostnamerewritepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "replacement_character", "x"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHostnamerewritepolicyResource_ValidCharacters(t *testing.T) {
+	var resourceName = "nios_ipam_hostnamerewritepolicy.test_valid_characters"
+	var v ipam.Hostnamerewritepolicy
+	validCharacters := "-0123456789abcdefghijklmnopqrstuvwxyz"
+	validCharactersUpdate := "-0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHostnamerewritepolicyValidCharacters(hostnamerewritepolicyName, validCharacters),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostnamerewritepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "valid_characters", validCharacters),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHostnamerewritepolicyValidCharacters(hostnamerewritepolicyName, validCharactersUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostnamerewritepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "valid_characters", validCharactersUpdate),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckHostnamerewritepolicyExists(ctx context.Context, resourceName string, v *ipam.Hostnamerewritepolicy) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.IPAMAPI.
+			HostnamerewritepolicyAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForHostnamerewritepolicy).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetHostnamerewritepolicyResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetHostnamerewritepolicyResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccHostnamerewritepolicyBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_hostnamerewritepolicy" "test" {
+    name = %q
+}
+`, name)
+}
+
+func testAccHostnamerewritepolicyReplacementCharacter(name, replacementCharacter string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_hostnamerewritepolicy" "test_replacement_character" {
+    name = %q
+    replacement_character = %q
+}
+`, name, replacementCharacter)
+}
+
+func testAccHostnamerewritepolicyValidCharacters(name, validCharacters string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_hostnamerewritepolicy" "test_valid_characters" {
+    name = %q
+    valid_characters = %q
+}
+`, name, validCharacters)
+}
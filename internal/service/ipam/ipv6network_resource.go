@@ -174,6 +174,11 @@ func (r *Ipv6networkResource) Create(ctx context.Context, req resource.CreateReq
 		data.FuncCall = types.ObjectValueMust(FuncCallAttrTypes, origFunCallAttrs)
 	}
 
+	// Wait for the RIR registration update requested along with the IPv6 Network to complete
+	if IsRirRegistrationRequested(payload.RirRegistrationAction, payload.SendRirRequest) {
+		data.LastRirRegistrationUpdateStatus = WaitForRirRegistrationUpdate(ctx, fmt.Sprintf("IPv6 Network %s", data.Network.ValueString()), nil, r.readLastRirRegistrationUpdateStatus(data.Ref.ValueString()), &resp.Diagnostics)
+	}
+
 	// Save the Identity of the Resource
 	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("ref"), &data.Ref)...)
 
@@ -382,6 +387,29 @@ func (r *Ipv6networkResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// Only send the RIR registration action when it has to be submitted to the RIR again
+	var stateRirRegistrationAction types.String
+	diags = req.State.GetAttribute(ctx, path.Root("rir_registration_action"), &stateRirRegistrationAction)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if !ShouldSendRirRegistrationAction(data.RirRegistrationAction, stateRirRegistrationAction) {
+		payload.RirRegistrationAction = nil
+		payload.SendRirRequest = nil
+	}
+
+	// Read the last RIR registration update status so that the wait below does not return the one of an earlier update
+	var previousRirRegistrationUpdateStatus *string
+	if IsRirRegistrationRequested(payload.RirRegistrationAction, payload.SendRirRequest) {
+		var readErr error
+		previousRirRegistrationUpdateStatus, readErr = r.readLastRirRegistrationUpdateStatus(data.Ref.ValueString())(ctx)
+		if readErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the RIR registration update status of IPv6 Network, got error: %s", readErr))
+			return
+		}
+	}
+
 	var apiRes *ipam.UpdateIpv6networkResponse
 
 	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
@@ -419,6 +447,11 @@ func (r *Ipv6networkResource) Update(ctx context.Context, req resource.UpdateReq
 
 	data.Flatten(ctx, &res, &resp.Diagnostics)
 
+	// Wait for the RIR registration update requested along with the IPv6 Network to complete
+	if IsRirRegistrationRequested(payload.RirRegistrationAction, payload.SendRirRequest) {
+		data.LastRirRegistrationUpdateStatus = WaitForRirRegistrationUpdate(ctx, fmt.Sprintf("IPv6 Network %s", data.Network.ValueString()), previousRirRegistrationUpdateStatus, r.readLastRirRegistrationUpdateStatus(data.Ref.ValueString()), &resp.Diagnostics)
+	}
+
 	// Save the Identity of the Resource
 	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("ref"), &data.Ref)...)
 
@@ -498,6 +531,22 @@ func (r *Ipv6networkResource) UpdateFuncCallAttributeName(ctx context.Context, d
 	return types.ObjectValueMust(FuncCallAttrTypes, updatedFuncCallAttrs)
 }
 
+func (r *Ipv6networkResource) readLastRirRegistrationUpdateStatus(ref string) func(ctx context.Context) (*string, error) {
+	return func(ctx context.Context) (*string, error) {
+		apiRes, _, err := r.client.IPAMAPI.
+			Ipv6networkAPI.
+			Read(ctx, utils.ExtractResourceRef(ref)).
+			ReturnFields("last_rir_registration_update_status").
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+		res := apiRes.GetIpv6networkResponseObjectAsResult.GetResult()
+		return res.LastRirRegistrationUpdateStatus, nil
+	}
+}
+
 func (r *Ipv6networkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	if req.Identity != nil && req.Identity.Raw.IsKnown() && !req.Identity.Raw.IsNull() {
 		diags := req.Identity.GetAttribute(ctx, path.Root("ref"), &req.ID)
@@ -0,0 +1,277 @@
+package ipam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	planmodifiers "github.com/infobloxopen/terraform-provider-nios/internal/planmodifiers/immutable"
+	importmod "github.com/infobloxopen/terraform-provider-nios/internal/planmodifiers/import"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type BulkhostModel struct {
+	Ref             types.String        `tfsdk:"ref"`
+	CloudInfo       types.Object        `tfsdk:"cloud_info"`
+	Comment         types.String        `tfsdk:"comment"`
+	Disable         types.Bool          `tfsdk:"disable"`
+	DnsPrefix       types.String        `tfsdk:"dns_prefix"`
+	EndAddr         iptypes.IPv4Address `tfsdk:"end_addr"`
+	ExtAttrs        types.Map           `tfsdk:"extattrs"`
+	ExtAttrsAll     types.Map           `tfsdk:"extattrs_all"`
+	LastQueried     types.Int64         `tfsdk:"last_queried"`
+	NameTemplate    types.String        `tfsdk:"name_template"`
+	NetworkView     types.String        `tfsdk:"network_view"`
+	Policy          types.String        `tfsdk:"policy"`
+	Prefix          types.String        `tfsdk:"prefix"`
+	Reverse         types.Bool          `tfsdk:"reverse"`
+	StartAddr       iptypes.IPv4Address `tfsdk:"start_addr"`
+	TemplateFormat  types.String        `tfsdk:"template_format"`
+	Ttl             types.Int64         `tfsdk:"ttl"`
+	UseNameTemplate types.Bool          `tfsdk:"use_name_template"`
+	UseTtl          types.Bool          `tfsdk:"use_ttl"`
+	View            types.String        `tfsdk:"view"`
+	Zone            types.String        `tfsdk:"zone"`
+}
+
+var BulkhostAttrTypes = map[string]attr.Type{
+	"ref":               types.StringType,
+	"cloud_info":        types.ObjectType{AttrTypes: BulkhostCloudInfoAttrTypes},
+	"comment":           types.StringType,
+	"disable":           types.BoolType,
+	"dns_prefix":        types.StringType,
+	"end_addr":          iptypes.IPv4AddressType{},
+	"extattrs":          types.MapType{ElemType: types.StringType},
+	"extattrs_all":      types.MapType{ElemType: types.StringType},
+	"last_queried":      types.Int64Type,
+	"name_template":     types.StringType,
+	"network_view":      types.StringType,
+	"policy":            types.StringType,
+	"prefix":            types.StringType,
+	"reverse":           types.BoolType,
+	"start_addr":        iptypes.IPv4AddressType{},
+	"template_format":   types.StringType,
+	"ttl":               types.Int64Type,
+	"use_name_template": types.BoolType,
+	"use_ttl":           types.BoolType,
+	"view":              types.StringType,
+	"zone":              types.StringType,
+}
+
+var BulkhostResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"cloud_info": schema.SingleNestedAttribute{
+		Attributes:          BulkhostCloudInfoResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The cloud information associated with the bulk host.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+			stringvalidator.LengthBetween(0, 256),
+		},
+		MarkdownDescription: "The descriptive comment.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "The disable flag of a DNS BulkHost record.",
+	},
+	"dns_prefix": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The prefix, in punycode format, for the bulk host.",
+	},
+	"end_addr": schema.StringAttribute{
+		CustomType:          iptypes.IPv4AddressType{},
+		Required:            true,
+		MarkdownDescription: "The last IP address in the address range for the bulk host.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:    true,
+		Computed:    true,
+		ElementType: types.StringType,
+		Default:     mapdefault.StaticValue(types.MapNull(types.StringType)),
+		Validators: []validator.Map{
+			mapvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		MarkdownDescription: "Extensible attributes associated with the object , including default and internal attributes.",
+		ElementType:         types.StringType,
+		PlanModifiers: []planmodifier.Map{
+			importmod.AssociateInternalId(),
+		},
+	},
+	"last_queried": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name_template": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+			stringvalidator.AlsoRequires(path.MatchRoot("use_name_template")),
+		},
+		MarkdownDescription: "The name of the bulk host name template used to generate the host names. The template must exist as a `nios_ipam_bulk_hostname_template`.",
+	},
+	"network_view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The network view associated with the bulk host view.",
+	},
+	"policy": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The hostname policy for records under the bulk host parent zone.",
+	},
+	"prefix": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The prefix for the bulk host. The prefix is the name (or a series of characters) inserted at the beginning of each host name.",
+	},
+	"reverse": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "The reverse flag of the BulkHost record. When set, PTR records are created for the generated host names in the matching reverse-mapping zone.",
+	},
+	"start_addr": schema.StringAttribute{
+		CustomType:          iptypes.IPv4AddressType{},
+		Required:            true,
+		MarkdownDescription: "The first IP address in the address range for the bulk host.",
+	},
+	"template_format": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The bulk host name template format.",
+	},
+	"ttl": schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+		MarkdownDescription: "The Time to Live (TTL) value.",
+	},
+	"use_name_template": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Use flag for: name_template",
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Use flag for: ttl",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			planmodifiers.ImmutableString(),
+		},
+		MarkdownDescription: "The view for the bulk host.",
+	},
+	"zone": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		PlanModifiers: []planmodifier.String{
+			planmodifiers.ImmutableString(),
+		},
+		MarkdownDescription: "The zone name.",
+	},
+}
+
+func (m *BulkhostModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *ipam.Bulkhost {
+	if m == nil {
+		return nil
+	}
+	to := &ipam.Bulkhost{
+		Comment:         flex.ExpandStringPointer(m.Comment),
+		Disable:         flex.ExpandBoolPointer(m.Disable),
+		EndAddr:         flex.ExpandIPv4Address(m.EndAddr),
+		ExtAttrs:        ExpandExtAttrs(ctx, m.ExtAttrs, diags),
+		NameTemplate:    flex.ExpandStringPointer(m.NameTemplate),
+		Prefix:          flex.ExpandStringPointer(m.Prefix),
+		Reverse:         flex.ExpandBoolPointer(m.Reverse),
+		StartAddr:       flex.ExpandIPv4Address(m.StartAddr),
+		Ttl:             flex.ExpandInt64Pointer(m.Ttl),
+		UseNameTemplate: flex.ExpandBoolPointer(m.UseNameTemplate),
+		UseTtl:          flex.ExpandBoolPointer(m.UseTtl),
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+		to.Zone = flex.ExpandStringPointer(m.Zone)
+	}
+	return to
+}
+
+func FlattenBulkhost(ctx context.Context, from *ipam.Bulkhost, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(BulkhostAttrTypes)
+	}
+	m := BulkhostModel{}
+	m.Flatten(ctx, from, diags)
+	m.ExtAttrsAll = types.MapNull(types.StringType)
+	t, d := types.ObjectValueFrom(ctx, BulkhostAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *BulkhostModel) Flatten(ctx context.Context, from *ipam.Bulkhost, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = BulkhostModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.CloudInfo = FlattenBulkhostCloudInfo(ctx, from.CloudInfo, diags)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DnsPrefix = flex.FlattenStringPointer(from.DnsPrefix)
+	m.EndAddr = flex.FlattenIPv4Address(from.EndAddr)
+	m.ExtAttrs = FlattenExtAttrs(ctx, m.ExtAttrs, from.ExtAttrs, diags)
+	m.LastQueried = flex.FlattenInt64Pointer(from.LastQueried)
+	m.NameTemplate = flex.FlattenStringPointer(from.NameTemplate)
+	m.NetworkView = flex.FlattenStringPointer(from.NetworkView)
+	m.Policy = flex.FlattenStringPointer(from.Policy)
+	m.Prefix = flex.FlattenStringPointer(from.Prefix)
+	m.Reverse = types.BoolPointerValue(from.Reverse)
+	m.StartAddr = flex.FlattenIPv4Address(from.StartAddr)
+	m.TemplateFormat = flex.FlattenStringPointer(from.TemplateFormat)
+	m.Ttl = flex.FlattenInt64Pointer(from.Ttl)
+	m.UseNameTemplate = types.BoolPointerValue(from.UseNameTemplate)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
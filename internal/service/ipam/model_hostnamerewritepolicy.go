@@ -0,0 +1,121 @@
+package ipam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type HostnamerewritepolicyModel struct {
+	Ref                  types.String `tfsdk:"ref"`
+	IsDefault            types.Bool   `tfsdk:"is_default"`
+	Name                 types.String `tfsdk:"name"`
+	PreDefined           types.Bool   `tfsdk:"pre_defined"`
+	ReplacementCharacter types.String `tfsdk:"replacement_character"`
+	ValidCharacters      types.String `tfsdk:"valid_characters"`
+}
+
+var HostnamerewritepolicyAttrTypes = map[string]attr.Type{
+	"ref":                   types.StringType,
+	"is_default":            types.BoolType,
+	"name":                  types.StringType,
+	"pre_defined":           types.BoolType,
+	"replacement_character": types.StringType,
+	"valid_characters":      types.StringType,
+}
+
+var HostnamerewritepolicyResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"is_default": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "True if the policy is the Grid default.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the hostname rewrite policy. The policy must already exist on the Grid.",
+	},
+	"pre_defined": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines whether the policy is a predefined one.",
+	},
+	"replacement_character": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 1),
+		},
+		MarkdownDescription: "The replacement character for symbols in hostnames that do not conform to the hostname policy.",
+	},
+	"valid_characters": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The set of valid characters represented in string format, e.g. `-0123456789abcdefghijklmnopqrstuvwxyz`.",
+	},
+}
+
+func (m *HostnamerewritepolicyModel) Expand(ctx context.Context, diags *diag.Diagnostics) *ipam.Hostnamerewritepolicy {
+	if m == nil {
+		return nil
+	}
+	to := &ipam.Hostnamerewritepolicy{
+		ReplacementCharacter: flex.ExpandStringPointer(m.ReplacementCharacter),
+		ValidCharacters:      flex.ExpandStringPointer(m.ValidCharacters),
+	}
+	return to
+}
+
+func FlattenHostnamerewritepolicy(ctx context.Context, from *ipam.Hostnamerewritepolicy, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(HostnamerewritepolicyAttrTypes)
+	}
+	m := HostnamerewritepolicyModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, HostnamerewritepolicyAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *HostnamerewritepolicyModel) Flatten(ctx context.Context, from *ipam.Hostnamerewritepolicy, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = HostnamerewritepolicyModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.IsDefault = types.BoolPointerValue(from.IsDefault)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.PreDefined = types.BoolPointerValue(from.PreDefined)
+	m.ReplacementCharacter = flex.FlattenStringPointer(from.ReplacementCharacter)
+	m.ValidCharacters = flex.FlattenStringPointer(from.ValidCharacters)
+}
@@ -600,7 +600,7 @@ var Ipv6networkResourceSchemaAttributes = map[string]schema.Attribute{
 	},
 	"rir_registration_action": schema.StringAttribute{
 		Optional:            true,
-		MarkdownDescription: "The RIR registration action.",
+		MarkdownDescription: "The RIR registration action. `CREATE` and `DELETE` are only sent to NIOS when the action changes, while `MODIFY` is sent on every update.",
 		Computed:            true,
 		Validators: []validator.String{
 			stringvalidator.OneOf("CREATE", "MODIFY", "DELETE", "NONE"),
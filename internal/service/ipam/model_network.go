@@ -893,7 +893,7 @@ var NetworkResourceSchemaAttributes = map[string]schema.Attribute{
 	},
 	"rir_registration_action": schema.StringAttribute{
 		Optional:            true,
-		MarkdownDescription: "The RIR registration action.",
+		MarkdownDescription: "The RIR registration action. `CREATE` and `DELETE` are only sent to NIOS when the action changes, while `MODIFY` is sent on every update.",
 		Validators: []validator.String{
 			stringvalidator.OneOf("CREATE", "MODIFY", "DELETE", "NONE"),
 		},
@@ -3,12 +3,15 @@ package ipam
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -32,6 +35,7 @@ type VlanModel struct {
 	ExtAttrs    types.Map    `tfsdk:"extattrs"`
 	ExtAttrsAll types.Map    `tfsdk:"extattrs_all"`
 	Id          types.Int64  `tfsdk:"id"`
+	FuncCall    types.Object `tfsdk:"func_call"`
 	Name        types.String `tfsdk:"name"`
 	Parent      types.String `tfsdk:"parent"`
 	Reserved    types.Bool   `tfsdk:"reserved"`
@@ -48,6 +52,7 @@ var VlanAttrTypes = map[string]attr.Type{
 	"extattrs":     types.MapType{ElemType: types.StringType},
 	"extattrs_all": types.MapType{ElemType: types.StringType},
 	"id":           types.Int64Type,
+	"func_call":    types.ObjectType{AttrTypes: FuncCallAttrTypes},
 	"name":         types.StringType,
 	"parent":       types.StringType,
 	"reserved":     types.BoolType,
@@ -120,8 +125,24 @@ var VlanResourceSchemaAttributes = map[string]schema.Attribute{
 		},
 	},
 	"id": schema.Int64Attribute{
-		Required:            true,
-		MarkdownDescription: "VLAN ID value.",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Int64{
+			int64validator.ExactlyOneOf(
+				path.MatchRoot("id"),
+				path.MatchRoot("func_call"),
+			),
+		},
+		MarkdownDescription: "VLAN ID value. This field is `required` unless a `func_call` is specified to invoke `next_available_vlan_id`.",
+	},
+	"func_call": schema.SingleNestedAttribute{
+		Attributes:          FuncCallResourceSchemaAttributes,
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Specifies the function call to execute. The `next_available_vlan_id` function is supported for Vlan.",
 	},
 	"name": schema.StringAttribute{
 		Required: true,
@@ -146,7 +167,7 @@ var VlanResourceSchemaAttributes = map[string]schema.Attribute{
 	},
 }
 
-func (m *VlanModel) Expand(ctx context.Context, diags *diag.Diagnostics) *ipam.Vlan {
+func (m *VlanModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *ipam.Vlan {
 	if m == nil {
 		return nil
 	}
@@ -161,6 +182,9 @@ func (m *VlanModel) Expand(ctx context.Context, diags *diag.Diagnostics) *ipam.V
 		Parent:      ExpandVlanParent(m.Parent),
 		Reserved:    flex.ExpandBoolPointer(m.Reserved),
 	}
+	if isCreate {
+		to.FuncCall = ExpandFuncCall(ctx, m.FuncCall, diags)
+	}
 	return to
 }
 
@@ -191,6 +215,9 @@ func (m *VlanModel) Flatten(ctx context.Context, from *ipam.Vlan, diags *diag.Di
 	m.Description = flex.FlattenStringPointer(from.Description)
 	m.ExtAttrs = FlattenExtAttrs(ctx, m.ExtAttrs, from.ExtAttrs, diags)
 	m.Id = FlattenVlanId(from.Id)
+	if m.FuncCall.IsNull() || m.FuncCall.IsUnknown() {
+		m.FuncCall = FlattenFuncCall(ctx, from.FuncCall, diags)
+	}
 	m.Name = flex.FlattenStringPointer(from.Name)
 	m.Parent = FlattenVlanParent(from.Parent)
 	m.Reserved = types.BoolPointerValue(from.Reserved)
@@ -216,8 +243,9 @@ func FlattenVlanParent(from *ipam.VlanParent) types.String {
 }
 
 func ExpandVlanId(val types.Int64) *ipam.VlanId {
-	if val.IsNull() {
-		return &ipam.VlanId{}
+	// The id is unknown when it is allocated through func_call, leave it out of the payload
+	if val.IsNull() || val.IsUnknown() {
+		return nil
 	}
 	var m ipam.VlanId
 	m.Int64 = flex.ExpandInt64Pointer(val)
@@ -173,6 +173,11 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 		data.FuncCall = types.ObjectValueMust(FuncCallAttrTypes, origFunCallAttrs)
 	}
 
+	// Wait for the RIR registration update requested along with the Network to complete
+	if IsRirRegistrationRequested(payload.RirRegistrationAction, payload.SendRirRequest) {
+		data.LastRirRegistrationUpdateStatus = WaitForRirRegistrationUpdate(ctx, fmt.Sprintf("Network %s", data.Network.ValueString()), nil, r.readLastRirRegistrationUpdateStatus(data.Ref.ValueString()), &resp.Diagnostics)
+	}
+
 	// Save the Identity of the Resource
 	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("ref"), &data.Ref)...)
 
@@ -381,6 +386,29 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// Only send the RIR registration action when it has to be submitted to the RIR again
+	var stateRirRegistrationAction types.String
+	diags = req.State.GetAttribute(ctx, path.Root("rir_registration_action"), &stateRirRegistrationAction)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if !ShouldSendRirRegistrationAction(data.RirRegistrationAction, stateRirRegistrationAction) {
+		payload.RirRegistrationAction = nil
+		payload.SendRirRequest = nil
+	}
+
+	// Read the last RIR registration update status so that the wait below does not return the one of an earlier update
+	var previousRirRegistrationUpdateStatus *string
+	if IsRirRegistrationRequested(payload.RirRegistrationAction, payload.SendRirRequest) {
+		var readErr error
+		previousRirRegistrationUpdateStatus, readErr = r.readLastRirRegistrationUpdateStatus(data.Ref.ValueString())(ctx)
+		if readErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the RIR registration update status of Network, got error: %s", readErr))
+			return
+		}
+	}
+
 	var apiRes *ipam.UpdateNetworkResponse
 
 	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
@@ -418,6 +446,11 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	data.Flatten(ctx, &res, &resp.Diagnostics)
 
+	// Wait for the RIR registration update requested along with the Network to complete
+	if IsRirRegistrationRequested(payload.RirRegistrationAction, payload.SendRirRequest) {
+		data.LastRirRegistrationUpdateStatus = WaitForRirRegistrationUpdate(ctx, fmt.Sprintf("Network %s", data.Network.ValueString()), previousRirRegistrationUpdateStatus, r.readLastRirRegistrationUpdateStatus(data.Ref.ValueString()), &resp.Diagnostics)
+	}
+
 	// Save the Identity of the Resource
 	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("ref"), &data.Ref)...)
 
@@ -497,6 +530,22 @@ func (r *NetworkResource) UpdateFuncCallAttributeName(ctx context.Context, data
 	return types.ObjectValueMust(FuncCallAttrTypes, updatedFuncCallAttrs)
 }
 
+func (r *NetworkResource) readLastRirRegistrationUpdateStatus(ref string) func(ctx context.Context) (*string, error) {
+	return func(ctx context.Context) (*string, error) {
+		apiRes, _, err := r.client.IPAMAPI.
+			NetworkAPI.
+			Read(ctx, utils.ExtractResourceRef(ref)).
+			ReturnFields("last_rir_registration_update_status").
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+		res := apiRes.GetNetworkResponseObjectAsResult.GetResult()
+		return res.LastRirRegistrationUpdateStatus, nil
+	}
+}
+
 func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	if req.Identity != nil && req.Identity.Raw.IsKnown() && !req.Identity.Raw.IsNull() {
 		diags := req.Identity.GetAttribute(ctx, path.Root("ref"), &req.ID)
@@ -159,6 +159,11 @@ func (r *NetworkcontainerResource) Create(ctx context.Context, req resource.Crea
 		data.FuncCall = types.ObjectValueMust(FuncCallAttrTypes, origFunCallAttrs)
 	}
 
+	// Wait for the RIR registration update requested along with the Network Container to complete
+	if IsRirRegistrationRequested(payload.RirRegistrationAction, payload.SendRirRequest) {
+		data.LastRirRegistrationUpdateStatus = WaitForRirRegistrationUpdate(ctx, fmt.Sprintf("Network Container %s", data.Network.ValueString()), nil, r.readLastRirRegistrationUpdateStatus(data.Ref.ValueString()), &resp.Diagnostics)
+	}
+
 	// Save the Identity of the Resource
 	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("ref"), &data.Ref)...)
 
@@ -367,6 +372,29 @@ func (r *NetworkcontainerResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	// Only send the RIR registration action when it has to be submitted to the RIR again
+	var stateRirRegistrationAction types.String
+	diags = req.State.GetAttribute(ctx, path.Root("rir_registration_action"), &stateRirRegistrationAction)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if !ShouldSendRirRegistrationAction(data.RirRegistrationAction, stateRirRegistrationAction) {
+		payload.RirRegistrationAction = nil
+		payload.SendRirRequest = nil
+	}
+
+	// Read the last RIR registration update status so that the wait below does not return the one of an earlier update
+	var previousRirRegistrationUpdateStatus *string
+	if IsRirRegistrationRequested(payload.RirRegistrationAction, payload.SendRirRequest) {
+		var readErr error
+		previousRirRegistrationUpdateStatus, readErr = r.readLastRirRegistrationUpdateStatus(data.Ref.ValueString())(ctx)
+		if readErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the RIR registration update status of Network Container, got error: %s", readErr))
+			return
+		}
+	}
+
 	var apiRes *ipam.UpdateNetworkcontainerResponse
 
 	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
@@ -404,6 +432,11 @@ func (r *NetworkcontainerResource) Update(ctx context.Context, req resource.Upda
 
 	data.Flatten(ctx, &res, &resp.Diagnostics)
 
+	// Wait for the RIR registration update requested along with the Network Container to complete
+	if IsRirRegistrationRequested(payload.RirRegistrationAction, payload.SendRirRequest) {
+		data.LastRirRegistrationUpdateStatus = WaitForRirRegistrationUpdate(ctx, fmt.Sprintf("Network Container %s", data.Network.ValueString()), previousRirRegistrationUpdateStatus, r.readLastRirRegistrationUpdateStatus(data.Ref.ValueString()), &resp.Diagnostics)
+	}
+
 	// Save the Identity of the Resource
 	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("ref"), &data.Ref)...)
 
@@ -467,6 +500,22 @@ func (r *NetworkcontainerResource) UpdateFuncCallAttributeName(ctx context.Conte
 	return types.ObjectValueMust(FuncCallAttrTypes, updatedFuncCallAttrs)
 }
 
+func (r *NetworkcontainerResource) readLastRirRegistrationUpdateStatus(ref string) func(ctx context.Context) (*string, error) {
+	return func(ctx context.Context) (*string, error) {
+		apiRes, _, err := r.client.IPAMAPI.
+			NetworkcontainerAPI.
+			Read(ctx, utils.ExtractResourceRef(ref)).
+			ReturnFields("last_rir_registration_update_status").
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+		res := apiRes.GetNetworkcontainerResponseObjectAsResult.GetResult()
+		return res.LastRirRegistrationUpdateStatus, nil
+	}
+}
+
 func (r *NetworkcontainerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	if req.Identity != nil && req.Identity.Raw.IsKnown() && !req.Identity.Raw.IsNull() {
 		diags := req.Identity.GetAttribute(ctx, path.Root("ref"), &req.ID)
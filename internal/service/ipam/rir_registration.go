@@ -0,0 +1,100 @@
+package ipam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+)
+
+const (
+	// RirRegistrationUpdateTimeout is the maximum amount of time to wait for a RIR registration update to complete
+	RirRegistrationUpdateTimeout = 5 * time.Minute
+)
+
+var errRirRegistrationUpdatePending = errors.New("RIR registration update is pending")
+
+// ShouldSendRirRegistrationAction reports whether the planned RIR registration action has to be sent to NIOS on update.
+// CREATE and DELETE are one-shot requests and are only sent when the action changes, while MODIFY is sent
+// on every update so that changes to the object are submitted to the RIR.
+func ShouldSendRirRegistrationAction(planAction, stateAction types.String) bool {
+	if planAction.IsNull() || planAction.IsUnknown() {
+		return false
+	}
+	return !planAction.Equal(stateAction) || planAction.ValueString() == "MODIFY"
+}
+
+// IsRirRegistrationRequested reports whether a RIR registration request is sent to NIOS along with the object.
+func IsRirRegistrationRequested(action *string, sendRirRequest *bool) bool {
+	return action != nil && *action != "NONE" && sendRirRequest != nil && *sendRirRequest
+}
+
+// WaitForRirRegistrationUpdate polls the last RIR registration update status of an object until the update requested
+// after previousStatus was read is done. A failed or incomplete update is reported as a warning, as the object itself
+// has been saved.
+func WaitForRirRegistrationUpdate(ctx context.Context, objectName string, previousStatus *string, readStatus func(ctx context.Context) (*string, error), diags *diag.Diagnostics) types.String {
+	var status, previous string
+	var seenPending bool
+	if previousStatus != nil {
+		previous = strings.TrimSpace(*previousStatus)
+	}
+
+	err := retry.DoWithTimeout(ctx, RirRegistrationUpdateTimeout, isRirRegistrationUpdatePending, func(ctx context.Context) (int, error) {
+		res, err := readStatus(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if res != nil {
+			status = strings.TrimSpace(*res)
+		}
+		seenPending = seenPending || isRirRegistrationStatusPending(status)
+		if !isRirRegistrationUpdateDone(status, previous, seenPending) {
+			tflog.Debug(ctx, fmt.Sprintf("Waiting for RIR registration update of %s to complete", objectName))
+			return 0, errRirRegistrationUpdatePending
+		}
+		return 0, nil
+	})
+
+	if err != nil {
+		diags.AddWarning(
+			"RIR Registration Update Incomplete",
+			fmt.Sprintf("Unable to confirm the RIR registration update of %s, got error: %s. Last known status: %q", objectName, err, status),
+		)
+	} else if lower := strings.ToLower(status); strings.Contains(lower, "fail") || strings.Contains(lower, "error") {
+		diags.AddWarning(
+			"RIR Registration Update Failed",
+			fmt.Sprintf("The RIR registration update of %s failed with status: %s", objectName, status),
+		)
+	}
+
+	if status == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(status)
+}
+
+// isRirRegistrationUpdateDone reports whether the RIR registration update is done. A status that is still the one read
+// before the request is stale, unless the update was seen pending in between and ended with the same status.
+func isRirRegistrationUpdateDone(status, previousStatus string, seenPending bool) bool {
+	if status == "" || isRirRegistrationStatusPending(status) {
+		return false
+	}
+	return status != previousStatus || seenPending
+}
+
+// isRirRegistrationStatusPending checks if the RIR registration update status reports a pending update.
+func isRirRegistrationStatusPending(status string) bool {
+	return strings.Contains(strings.ToLower(status), "pending")
+}
+
+// isRirRegistrationUpdatePending checks if the error indicates that the RIR registration update is still pending.
+func isRirRegistrationUpdatePending(err error) bool {
+	return errors.Is(err, errRirRegistrationUpdatePending) || retry.TransientErrors(err)
+}
@@ -0,0 +1,59 @@
+package ipam
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShouldSendRirRegistrationAction(t *testing.T) {
+	tests := []struct {
+		name        string
+		planAction  types.String
+		stateAction types.String
+		want        bool
+	}{
+		{"null plan", types.StringNull(), types.StringValue("CREATE"), false},
+		{"unknown plan", types.StringUnknown(), types.StringValue("CREATE"), false},
+		{"create from null state", types.StringValue("CREATE"), types.StringNull(), true},
+		{"create unchanged", types.StringValue("CREATE"), types.StringValue("CREATE"), false},
+		{"delete unchanged", types.StringValue("DELETE"), types.StringValue("DELETE"), false},
+		{"create to delete", types.StringValue("DELETE"), types.StringValue("CREATE"), true},
+		{"modify unchanged", types.StringValue("MODIFY"), types.StringValue("MODIFY"), true},
+		{"create to modify", types.StringValue("MODIFY"), types.StringValue("CREATE"), true},
+		{"none unchanged", types.StringValue("NONE"), types.StringValue("NONE"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShouldSendRirRegistrationAction(tt.planAction, tt.stateAction); got != tt.want {
+				t.Errorf("ShouldSendRirRegistrationAction(%s, %s) = %t, expected %t", tt.planAction, tt.stateAction, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRirRegistrationUpdateDone(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         string
+		previousStatus string
+		seenPending    bool
+		want           bool
+	}{
+		{"no status yet", "", "", false, false},
+		{"pending", "Pending registration", "", true, false},
+		{"first update", "Registration successful", "", false, true},
+		{"stale status", "Registration successful", "Registration successful", false, false},
+		{"same status after pending", "Registration successful", "Registration successful", true, true},
+		{"changed status", "Registration failed", "Registration successful", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRirRegistrationUpdateDone(tt.status, tt.previousStatus, tt.seenPending); got != tt.want {
+				t.Errorf("isRirRegistrationUpdateDone(%q, %q, %t) = %t, expected %t", tt.status, tt.previousStatus, tt.seenPending, got, tt.want)
+			}
+		})
+	}
+}
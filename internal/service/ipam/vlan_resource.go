@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
@@ -97,7 +98,17 @@ func (r *VlanResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	payload := data.Expand(ctx, &resp.Diagnostics)
+	// If the function call attributes are set, update the attribute name to match tfsdk tag
+	origFunCallAttrs := data.FuncCall.Attributes()
+	if len(origFunCallAttrs) > 0 {
+		data.FuncCall = r.UpdateFuncCallAttributeName(ctx, data, &resp.Diagnostics)
+
+		// Serialize next available VLAN ID allocation within the same parent
+		utils.GlobalMutexStore.Lock(data.Parent.ValueString())
+		defer utils.GlobalMutexStore.Unlock(data.Parent.ValueString())
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics, true)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -146,6 +157,11 @@ func (r *VlanResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	data.Flatten(ctx, &res, &resp.Diagnostics)
 
+	// Retain the original function call attributes
+	if len(origFunCallAttrs) > 0 {
+		data.FuncCall = types.ObjectValueMust(FuncCallAttrTypes, origFunCallAttrs)
+	}
+
 	// Save the Identity of the Resource
 	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("ref"), &data.Ref)...)
 
@@ -349,7 +365,7 @@ func (r *VlanResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	payload := data.Expand(ctx, &resp.Diagnostics)
+	payload := data.Expand(ctx, &resp.Diagnostics, false)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -434,6 +450,20 @@ func (r *VlanResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+func (r *VlanResource) UpdateFuncCallAttributeName(ctx context.Context, data VlanModel, diags *diag.Diagnostics) types.Object {
+
+	updatedFuncCallAttrs := data.FuncCall.Attributes()
+	attrVal := updatedFuncCallAttrs["attribute_name"].(types.String).ValueString()
+	pathVar, err := utils.FindModelFieldByTFSdkTag(data, attrVal)
+	if !err {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to find attribute '%s' in Vlan model, got error", attrVal))
+		return types.ObjectNull(FuncCallAttrTypes)
+	}
+	updatedFuncCallAttrs["attribute_name"] = types.StringValue(pathVar)
+
+	return types.ObjectValueMust(FuncCallAttrTypes, updatedFuncCallAttrs)
+}
+
 func (r *VlanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	if req.Identity != nil && req.Identity.Raw.IsKnown() && !req.Identity.Raw.IsNull() {
 		diags := req.Identity.GetAttribute(ctx, path.Root("ref"), &req.ID)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -415,6 +416,53 @@ func TestAccVlanResource_Reserved(t *testing.T) {
 	})
 }
 
+func TestAccVlanResource_FuncCall(t *testing.T) {
+	var resourceName = "nios_ipam_vlan.test_func_call"
+	var v ipam.Vlan
+	name := acctest.RandomNameWithPrefix("vlan")
+	view := acctest.RandomNameWithPrefix("example-vlan-view")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccVlanFuncCall(name, view, "vlan_ids", "next_available_vlan_id", "vlanview", "example comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVlanExists(context.Background(), resourceName, &v),
+					testAccCheckVlanIdInRange(resourceName, 50, 100),
+					resource.TestCheckResourceAttr(resourceName, "func_call.attribute_name", "id"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccVlanFuncCall(name, view, "vlan_ids", "next_available_vlan_id", "vlanview", "example comment updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVlanExists(context.Background(), resourceName, &v),
+					testAccCheckVlanIdInRange(resourceName, 50, 100),
+					resource.TestCheckResourceAttr(resourceName, "comment", "example comment updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckVlanIdInRange checks that the allocated VLAN ID falls in the range of the parent VLAN view
+func testAccCheckVlanIdInRange(resourceName string, start, end int64) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid VLAN ID %q: %w", value, err)
+		}
+		if id < start || id > end {
+			return fmt.Errorf("expected VLAN ID in range %d-%d, got %d", start, end, id)
+		}
+		return nil
+	})
+}
+
 func testAccCheckVlanExists(ctx context.Context, resourceName string, v *ipam.Vlan) resource.TestCheckFunc {
 	// Verify the resource exists in the cloud
 	return func(state *terraform.State) error {
@@ -633,3 +681,23 @@ resource "nios_ipam_vlan" "test_reserved" {
 `, id, name, parent, reserved)
 	return strings.Join([]string{testAccBaseWithVlanView(parent), config}, "")
 }
+
+func testAccVlanFuncCall(name, parent, resultField, objectFunction, object, comment string) string {
+	config := fmt.Sprintf(`
+resource "nios_ipam_vlan" "test_func_call" {
+    name = %q
+    parent = nios_ipam_vlanview.%s.ref
+    comment = %q
+    func_call = {
+        attribute_name = "id"
+        object_function = %q
+        result_field = %q
+        object = %q
+        object_parameters = {
+            name = nios_ipam_vlanview.%s.name
+        }
+    }
+}
+`, name, parent, comment, objectFunction, resultField, object, parent)
+	return strings.Join([]string{testAccBaseWithVlanView(parent), config}, "")
+}
@@ -0,0 +1,47 @@
+package microsoft_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// Active Directory Sites Domain - example.local (synchronised from a Microsoft Server in the default network view)
+
+func TestAccMsserverAdsitesDomainDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_microsoft_msserver_adsites_domain.test"
+	name := "example.local"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMsserverAdsitesDomainDataSourceConfigFilters(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.network_view", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.netbios"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccMsserverAdsitesDomainDataSourceConfigFilters(name string) string {
+	return fmt.Sprintf(`
+data "nios_microsoft_msserver_adsites_domain" "test" {
+  filters = {
+	name = %q
+  }
+}
+`, name)
+}
@@ -0,0 +1,286 @@
+package microsoft
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/microsoft"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForMsserverDhcp = "address,comment,dhcp_utilization,dhcp_utilization_status,dynamic_hosts,last_sync_ts,login_name,network_view,next_sync_control,read_only,server_name,static_hosts,status,status_detail,status_last_updated,supports_failover,synchronization_interval,total_hosts,use_login,use_synchronization_interval"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MsserverDhcpResource{}
+var _ resource.ResourceWithImportState = &MsserverDhcpResource{}
+var _ resource.ResourceWithModifyPlan = &MsserverDhcpResource{}
+
+func NewMsserverDhcpResource() resource.Resource {
+	return &MsserverDhcpResource{}
+}
+
+// MsserverDhcpResource defines the resource implementation.
+type MsserverDhcpResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *MsserverDhcpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "microsoft_msserver_dhcp"
+}
+
+func (r *MsserverDhcpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DHCP service settings of a Microsoft Server. The DHCP service is created by NIOS along with the Microsoft Server, so this resource adopts the existing object on create and only clears it from state on delete.",
+		Attributes:          MsserverDhcpResourceSchemaAttributes,
+	}
+}
+
+func (r *MsserverDhcpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MsserverDhcpResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyLoginPasswordPlan(ctx, req, resp)
+}
+
+func (r *MsserverDhcpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MsserverDhcpModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, _, err := r.client.MicrosoftAPI.
+		MsserverDhcpAPI.
+		List(ctx).
+		Filters(map[string]interface{}{
+			"address": data.Address.ValueString(),
+		}).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForMsserverDhcp).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list MsserverDhcp: %s", err))
+		return
+	}
+
+	list := listResp.ListMsserverDhcpResponseObject.GetResult()
+
+	if len(list) == 0 {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("No Microsoft Server DHCP service exists for address %q. Add the Microsoft Server using nios_microsoft_msserver first.", data.Address.ValueString()))
+		return
+	}
+
+	// Update the existing DHCP service with desired plan
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("login_password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !password.IsNull() && !password.IsUnknown() {
+		payload.LoginPassword = password.ValueStringPointer()
+	}
+	passwordVersion := storeLoginPasswordHash(ctx, password, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *microsoft.UpdateMsserverDhcpResponse
+
+	err = retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.MicrosoftAPI.
+			MsserverDhcpAPI.
+			Update(ctx, utils.ExtractResourceRef(list[0].GetRef())).
+			MsserverDhcp(*payload).
+			ReturnFieldsPlus(readableAttributesForMsserverDhcp).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create MsserverDhcp, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateMsserverDhcpResponseAsObject.GetResult()
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+	data.PasswordVersion = passwordVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MsserverDhcpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MsserverDhcpModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		httpRes *http.Response
+		apiRes  *microsoft.GetMsserverDhcpResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.MicrosoftAPI.
+			MsserverDhcpAPI.
+			Read(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+			ReturnFieldsPlus(readableAttributesForMsserverDhcp).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read MsserverDhcp, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetMsserverDhcpResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+	// The password version is not set on import
+	if data.PasswordVersion.IsNull() {
+		data.PasswordVersion = types.Int64Value(0)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MsserverDhcpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		diags diag.Diagnostics
+		data  MsserverDhcpModel
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var password types.String
+	var statePasswordVersion types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("login_password"), &password)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password_version"), &statePasswordVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The login password is only sent when it changed
+	if !password.IsNull() && !password.IsUnknown() && !data.PasswordVersion.Equal(statePasswordVersion) {
+		payload.LoginPassword = password.ValueStringPointer()
+	}
+
+	var apiRes *microsoft.UpdateMsserverDhcpResponse
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.MicrosoftAPI.
+			MsserverDhcpAPI.
+			Update(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+			MsserverDhcp(*payload).
+			ReturnFieldsPlus(readableAttributesForMsserverDhcp).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MsserverDhcp, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateMsserverDhcpResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MsserverDhcpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The DHCP service is removed together with its Microsoft Server, so just clear state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *MsserverDhcpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,262 @@
+package microsoft_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/microsoft"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForMsserverDhcp = "address,comment,dhcp_utilization,dhcp_utilization_status,dynamic_hosts,last_sync_ts,login_name,network_view,next_sync_control,read_only,server_name,static_hosts,status,status_detail,status_last_updated,supports_failover,synchronization_interval,total_hosts,use_login,use_synchronization_interval"
+
+func TestAccMsserverDhcpResource_basic(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dhcp.test"
+	var v microsoft.MsserverDhcp
+
+	address := "10.10.1.1"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMsserverDhcpBasicConfig(address, loginName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address", address),
+					resource.TestCheckResourceAttr(resourceName, "read_only", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_login", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_synchronization_interval", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMsserverDhcpResource_Import(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dhcp.test"
+	var v microsoft.MsserverDhcp
+
+	address := "10.10.1.2"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMsserverDhcpBasicConfig(address, loginName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccMsserverDhcpImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func TestAccMsserverDhcpResource_Login(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dhcp.test_login"
+	var v microsoft.MsserverDhcp
+
+	address := "10.10.1.3"
+	loginName := acctest.RandomName()
+	dhcpLoginName1 := acctest.RandomName()
+	dhcpLoginName2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMsserverDhcpLogin(address, loginName, dhcpLoginName1, "Example-Pass1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_login", "true"),
+					resource.TestCheckResourceAttr(resourceName, "login_name", dhcpLoginName1),
+					resource.TestCheckResourceAttr(resourceName, "password_version", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "login_password"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMsserverDhcpLogin(address, loginName, dhcpLoginName2, "Example-Pass2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_login", "true"),
+					resource.TestCheckResourceAttr(resourceName, "login_name", dhcpLoginName2),
+					resource.TestCheckResourceAttr(resourceName, "password_version", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "login_password"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMsserverDhcpResource_NextSyncControl(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dhcp.test_next_sync_control"
+	var v microsoft.MsserverDhcp
+
+	address := "10.10.1.4"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMsserverDhcpNextSyncControl(address, loginName, "STOP"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "next_sync_control", "STOP"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMsserverDhcpNextSyncControl(address, loginName, "START"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "next_sync_control", "START"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMsserverDhcpResource_SynchronizationInterval(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dhcp.test_synchronization_interval"
+	var v microsoft.MsserverDhcp
+
+	address := "10.10.1.5"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMsserverDhcpSynchronizationInterval(address, loginName, "5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "synchronization_interval", "5"),
+					resource.TestCheckResourceAttr(resourceName, "use_synchronization_interval", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMsserverDhcpSynchronizationInterval(address, loginName, "10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "synchronization_interval", "10"),
+					resource.TestCheckResourceAttr(resourceName, "use_synchronization_interval", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckMsserverDhcpExists(ctx context.Context, resourceName string, v *microsoft.MsserverDhcp) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.MicrosoftAPI.
+			MsserverDhcpAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForMsserverDhcp).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetMsserverDhcpResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetMsserverDhcpResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccMsserverDhcpImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccMsserverDhcpParentConfig(address, loginName string) string {
+	return fmt.Sprintf(`
+resource "nios_microsoft_msserver" "parent" {
+	address = %q
+	login_name = %q
+}
+`, address, loginName)
+}
+
+func testAccMsserverDhcpBasicConfig(address, loginName string) string {
+	config := `
+resource "nios_microsoft_msserver_dhcp" "test" {
+	address = nios_microsoft_msserver.parent.address
+}
+`
+	return testAccMsserverDhcpParentConfig(address, loginName) + config
+}
+
+func testAccMsserverDhcpLogin(address, loginName, dhcpLoginName, dhcpLoginPassword string) string {
+	config := fmt.Sprintf(`
+resource "nios_microsoft_msserver_dhcp" "test_login" {
+	address = nios_microsoft_msserver.parent.address
+	login_name = %q
+	login_password = %q
+	use_login = true
+}
+`, dhcpLoginName, dhcpLoginPassword)
+	return testAccMsserverDhcpParentConfig(address, loginName) + config
+}
+
+func testAccMsserverDhcpNextSyncControl(address, loginName, nextSyncControl string) string {
+	config := fmt.Sprintf(`
+resource "nios_microsoft_msserver_dhcp" "test_next_sync_control" {
+	address = nios_microsoft_msserver.parent.address
+	next_sync_control = %q
+}
+`, nextSyncControl)
+	return testAccMsserverDhcpParentConfig(address, loginName) + config
+}
+
+func testAccMsserverDhcpSynchronizationInterval(address, loginName, synchronizationInterval string) string {
+	config := fmt.Sprintf(`
+resource "nios_microsoft_msserver_dhcp" "test_synchronization_interval" {
+	address = nios_microsoft_msserver.parent.address
+	synchronization_interval = %s
+	use_synchronization_interval = true
+}
+`, synchronizationInterval)
+	return testAccMsserverDhcpParentConfig(address, loginName) + config
+}
@@ -0,0 +1,286 @@
+package microsoft
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/microsoft"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForMsserverDns = "address,enable_dns_reports_sync,login_name,synchronization_interval,use_enable_dns_reports_sync,use_login,use_synchronization_interval"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MsserverDnsResource{}
+var _ resource.ResourceWithImportState = &MsserverDnsResource{}
+var _ resource.ResourceWithModifyPlan = &MsserverDnsResource{}
+
+func NewMsserverDnsResource() resource.Resource {
+	return &MsserverDnsResource{}
+}
+
+// MsserverDnsResource defines the resource implementation.
+type MsserverDnsResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *MsserverDnsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "microsoft_msserver_dns"
+}
+
+func (r *MsserverDnsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DNS service settings of a Microsoft Server. The DNS service is created by NIOS along with the Microsoft Server, so this resource adopts the existing object on create and only clears it from state on delete.",
+		Attributes:          MsserverDnsResourceSchemaAttributes,
+	}
+}
+
+func (r *MsserverDnsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MsserverDnsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyLoginPasswordPlan(ctx, req, resp)
+}
+
+func (r *MsserverDnsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MsserverDnsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, _, err := r.client.MicrosoftAPI.
+		MsserverDnsAPI.
+		List(ctx).
+		Filters(map[string]interface{}{
+			"address": data.Address.ValueString(),
+		}).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForMsserverDns).
+		ProxySearch(config.GetProxySearch()).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list MsserverDns: %s", err))
+		return
+	}
+
+	list := listResp.ListMsserverDnsResponseObject.GetResult()
+
+	if len(list) == 0 {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("No Microsoft Server DNS service exists for address %q. Add the Microsoft Server using nios_microsoft_msserver first.", data.Address.ValueString()))
+		return
+	}
+
+	// Update the existing DNS service with desired plan
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("login_password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !password.IsNull() && !password.IsUnknown() {
+		payload.LoginPassword = password.ValueStringPointer()
+	}
+	passwordVersion := storeLoginPasswordHash(ctx, password, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *microsoft.UpdateMsserverDnsResponse
+
+	err = retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.MicrosoftAPI.
+			MsserverDnsAPI.
+			Update(ctx, utils.ExtractResourceRef(list[0].GetRef())).
+			MsserverDns(*payload).
+			ReturnFieldsPlus(readableAttributesForMsserverDns).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create MsserverDns, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateMsserverDnsResponseAsObject.GetResult()
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+	data.PasswordVersion = passwordVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MsserverDnsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MsserverDnsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		httpRes *http.Response
+		apiRes  *microsoft.GetMsserverDnsResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.MicrosoftAPI.
+			MsserverDnsAPI.
+			Read(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+			ReturnFieldsPlus(readableAttributesForMsserverDns).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read MsserverDns, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetMsserverDnsResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+	// The password version is not set on import
+	if data.PasswordVersion.IsNull() {
+		data.PasswordVersion = types.Int64Value(0)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MsserverDnsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		diags diag.Diagnostics
+		data  MsserverDnsModel
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var password types.String
+	var statePasswordVersion types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("login_password"), &password)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password_version"), &statePasswordVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The login password is only sent when it changed
+	if !password.IsNull() && !password.IsUnknown() && !data.PasswordVersion.Equal(statePasswordVersion) {
+		payload.LoginPassword = password.ValueStringPointer()
+	}
+
+	var apiRes *microsoft.UpdateMsserverDnsResponse
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.MicrosoftAPI.
+			MsserverDnsAPI.
+			Update(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+			MsserverDns(*payload).
+			ReturnFieldsPlus(readableAttributesForMsserverDns).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MsserverDns, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateMsserverDnsResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MsserverDnsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The DNS service is removed together with its Microsoft Server, so just clear state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *MsserverDnsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,108 @@
+package microsoft
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func hashLoginPassword(password types.String) string {
+	h := sha256.New()
+	h.Write([]byte(password.ValueString()))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func marshalLoginPasswordHash(hash string) ([]byte, error) {
+	secretDataJSON, err := json.Marshal(secretsHashState{Password: hash})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]string{"algo": "sha256", "hash": string(secretDataJSON)})
+}
+
+// modifyLoginPasswordPlan increments password_version when the configured login_password differs from the
+// one whose hash is kept in private state.
+func modifyLoginPasswordPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateRev types.Int64
+	var planPassword types.String
+
+	curRev := int64(0)
+	if !req.State.Raw.IsNull() && req.State.Raw.IsKnown() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password_version"), &stateRev)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !stateRev.IsNull() && !stateRev.IsUnknown() {
+			curRev = stateRev.ValueInt64()
+		}
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("login_password"), &planPassword)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planPassword.IsNull() || planPassword.IsUnknown() {
+		return
+	}
+
+	var prev struct {
+		Algo string `json:"algo"`
+		Hash string `json:"hash"`
+	}
+	prevHashes := secretsHashState{}
+
+	if b, diags := req.Private.GetKey(ctx, "password_hash"); diags != nil {
+		resp.Diagnostics.Append(diags...)
+	} else if b != nil {
+		if err := json.Unmarshal(b, &prev); err != nil {
+			prev.Hash = ""
+		}
+	}
+	if prev.Hash != "" {
+		_ = json.Unmarshal([]byte(prev.Hash), &prevHashes)
+	}
+
+	plannedHash := hashLoginPassword(planPassword)
+	if plannedHash == prevHashes.Password {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_version"), curRev)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_version"), types.Int64Value(curRev+1))...)
+	b, err := marshalLoginPasswordHash(plannedHash)
+	if err != nil {
+		resp.Diagnostics.AddError("Private State Marshal Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "password_hash", b)...)
+}
+
+// storeLoginPasswordHash keeps the hash of a newly set login password in private state and returns the initial
+// password_version.
+func storeLoginPasswordHash(ctx context.Context, password types.String, private privateStateSetter, diags *diag.Diagnostics) types.Int64 {
+	if password.IsNull() || password.IsUnknown() {
+		return types.Int64Value(0)
+	}
+	b, err := marshalLoginPasswordHash(hashLoginPassword(password))
+	if err != nil {
+		diags.AddError("Private State Marshal Error", err.Error())
+		return types.Int64Value(0)
+	}
+	diags.Append(private.SetKey(ctx, "password_hash", b)...)
+	return types.Int64Value(1)
+}
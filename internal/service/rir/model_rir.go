@@ -0,0 +1,92 @@
+package rir
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/rir"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type RirModel struct {
+	Ref               types.String `tfsdk:"ref"`
+	CommunicationMode types.String `tfsdk:"communication_mode"`
+	Email             types.String `tfsdk:"email"`
+	Name              types.String `tfsdk:"name"`
+	Url               types.String `tfsdk:"url"`
+	UseEmail          types.Bool   `tfsdk:"use_email"`
+	UseUrl            types.Bool   `tfsdk:"use_url"`
+}
+
+var RirAttrTypes = map[string]attr.Type{
+	"ref":                types.StringType,
+	"communication_mode": types.StringType,
+	"email":              types.StringType,
+	"name":               types.StringType,
+	"url":                types.StringType,
+	"use_email":          types.BoolType,
+	"use_url":            types.BoolType,
+}
+
+var RirResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"communication_mode": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The communication mode for RIR.",
+	},
+	"email": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The e-mail address for RIR.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of RIR.",
+	},
+	"url": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The WebAPI URL for RIR.",
+	},
+	"use_email": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Use flag for: email",
+	},
+	"use_url": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Use flag for: url",
+	},
+}
+
+func FlattenRir(ctx context.Context, from *rir.Rir, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RirAttrTypes)
+	}
+	m := RirModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RirAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RirModel) Flatten(ctx context.Context, from *rir.Rir, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RirModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.CommunicationMode = flex.FlattenStringPointer(from.CommunicationMode)
+	m.Email = flex.FlattenStringPointer(from.Email)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Url = flex.FlattenStringPointer(from.Url)
+	m.UseEmail = types.BoolPointerValue(from.UseEmail)
+	m.UseUrl = types.BoolPointerValue(from.UseUrl)
+}
@@ -0,0 +1,42 @@
+package rir_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRirDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_rir.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRirDataSourceConfigFilters("RIPE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", "RIPE"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.communication_mode"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRirDataSourceConfigFilters(name string) string {
+	return fmt.Sprintf(`
+data "nios_rir" "test" {
+  filters = {
+    name = %q
+  }
+}
+`, name)
+}
@@ -0,0 +1,206 @@
+package rpz
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+const (
+	rpzRulesetTriggerName            = "NAME"
+	rpzRulesetTriggerIpAddress       = "IP_ADDRESS"
+	rpzRulesetTriggerClientIpAddress = "CLIENT_IP_ADDRESS"
+
+	rpzRulesetPolicyNxdomain   = "NXDOMAIN"
+	rpzRulesetPolicyNodata     = "NODATA"
+	rpzRulesetPolicyPassthru   = "PASSTHRU"
+	rpzRulesetPolicySubstitute = "SUBSTITUTE"
+)
+
+// rpzRulesetRecordTypes maps the trigger of a rule set to the WAPI object type of its rules.
+var rpzRulesetRecordTypes = map[string]string{
+	rpzRulesetTriggerName:            "record:rpz:cname",
+	rpzRulesetTriggerIpAddress:       "record:rpz:cname:ipaddress",
+	rpzRulesetTriggerClientIpAddress: "record:rpz:cname:clientipaddress",
+}
+
+type RpzRulesetModel struct {
+	RpZone         types.String `tfsdk:"rp_zone"`
+	View           types.String `tfsdk:"view"`
+	Trigger        types.String `tfsdk:"trigger"`
+	Policy         types.String `tfsdk:"policy"`
+	SubstituteName types.String `tfsdk:"substitute_name"`
+	Entries        types.List   `tfsdk:"entries"`
+	EntriesHash    types.String `tfsdk:"entries_hash"`
+	EntryCount     types.Int64  `tfsdk:"entry_count"`
+}
+
+var RpzRulesetResourceSchemaAttributes = map[string]schema.Attribute{
+	"rp_zone": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the Response Policy Zone the rules are created in.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the DNS view of the Response Policy Zone.",
+	},
+	"trigger": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.OneOf(rpzRulesetTriggerName, rpzRulesetTriggerIpAddress, rpzRulesetTriggerClientIpAddress),
+		},
+		MarkdownDescription: "What the entries match on. `NAME` matches queried domain names, `IP_ADDRESS` matches IP addresses or networks " +
+			"in responses and `CLIENT_IP_ADDRESS` matches the IP address or network of the querying client.",
+	},
+	"policy": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(rpzRulesetPolicyNxdomain, rpzRulesetPolicyNodata, rpzRulesetPolicyPassthru, rpzRulesetPolicySubstitute),
+		},
+		MarkdownDescription: "The policy applied to the entries. Valid values are `NXDOMAIN`, `NODATA`, `PASSTHRU` and `SUBSTITUTE`. " +
+			"`SUBSTITUTE` requires `substitute_name` and is not supported for the `CLIENT_IP_ADDRESS` trigger.",
+	},
+	"substitute_name": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The domain name that is returned instead of the queried name. Only used with the `SUBSTITUTE` policy.",
+	},
+	"entries": schema.ListAttribute{
+		ElementType: types.StringType,
+		Required:    true,
+		WriteOnly:   true,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(
+				stringvalidator.LengthAtLeast(1),
+				customvalidator.ValidateTrimmedString(),
+			),
+		},
+		MarkdownDescription: "The domain names, or the IP addresses and networks in CIDR notation, the policy is applied to. The order and " +
+			"duplicates are ignored. This is a write-only attribute, only its hash is stored in the state.",
+	},
+	"entries_hash": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The SHA-256 hash of the entries of the rule set and the canonical names of their rules in the Response Policy Zone.",
+	},
+	"entry_count": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of entries of the rule set that have a rule in the Response Policy Zone.",
+	},
+}
+
+// recordType returns the WAPI object type of the rules of the rule set.
+func (m *RpzRulesetModel) recordType() string {
+	return rpzRulesetRecordTypes[m.Trigger.ValueString()]
+}
+
+// normalizeEntries validates the configured entries and returns them sorted and without duplicates.
+func (m *RpzRulesetModel) normalizeEntries(entries []string) ([]string, error) {
+	normalized := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		entry, err := m.normalizeEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, entry)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// normalizeEntry returns the entry in the form it is compared in. Domain names are compared
+// case-insensitively and without the trailing dot, IP addresses and networks in their canonical notation.
+func (m *RpzRulesetModel) normalizeEntry(entry string) (string, error) {
+	entry = strings.TrimSuffix(strings.ToLower(entry), ".")
+	if m.Trigger.ValueString() == rpzRulesetTriggerName {
+		if entry == "" {
+			return "", fmt.Errorf("empty domain name")
+		}
+		return entry, nil
+	}
+	if ip := net.ParseIP(entry); ip != nil {
+		return ip.String(), nil
+	}
+	if _, network, err := net.ParseCIDR(entry); err == nil {
+		return network.String(), nil
+	}
+	return "", fmt.Errorf("%q is not a valid IP address or network", entry)
+}
+
+// canonical returns the normalized canonical name of the rule that applies the policy to entry.
+func (m *RpzRulesetModel) canonical(entry string) string {
+	switch m.Policy.ValueString() {
+	case rpzRulesetPolicyNodata:
+		return "*"
+	case rpzRulesetPolicyPassthru:
+		switch {
+		case m.Trigger.ValueString() == rpzRulesetTriggerClientIpAddress:
+			return "rpz-passthru"
+		case strings.HasPrefix(entry, "*."):
+			return "infoblox-passthru"
+		}
+		return normalizeCanonical(entry)
+	case rpzRulesetPolicySubstitute:
+		return normalizeCanonical(m.SubstituteName.ValueString())
+	}
+	return ""
+}
+
+// normalizeCanonical returns the canonical name of a rule in the form it is compared in.
+func normalizeCanonical(canonical string) string {
+	canonical = strings.TrimSuffix(strings.ToLower(canonical), ".")
+	if ip := net.ParseIP(canonical); ip != nil {
+		return ip.String()
+	}
+	if _, network, err := net.ParseCIDR(canonical); err == nil {
+		return network.String()
+	}
+	return canonical
+}
+
+// hashRpzRulesetEntries returns the hash stored in the state for the normalized entries and their canonical names.
+func hashRpzRulesetEntries(canonicals map[string]string) string {
+	entries := slices.Sorted(maps.Keys(canonicals))
+	h := sha256.New()
+	for _, entry := range entries {
+		h.Write([]byte(entry))
+		h.Write([]byte{'\t'})
+		h.Write([]byte(canonicals[entry]))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,207 @@
+package rpz
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+func newRpzRulesetModel(trigger, policy, substituteName string) *RpzRulesetModel {
+	m := &RpzRulesetModel{
+		RpZone:         types.StringValue("rpz.example.com"),
+		View:           types.StringValue("default"),
+		Trigger:        types.StringValue(trigger),
+		Policy:         types.StringValue(policy),
+		SubstituteName: types.StringNull(),
+	}
+	if substituteName != "" {
+		m.SubstituteName = types.StringValue(substituteName)
+	}
+	return m
+}
+
+func TestRpzRulesetCanonical(t *testing.T) {
+	tests := []struct {
+		trigger        string
+		policy         string
+		substituteName string
+		entry          string
+		expected       string
+	}{
+		{rpzRulesetTriggerName, rpzRulesetPolicyNxdomain, "", "bad.example.com", ""},
+		{rpzRulesetTriggerName, rpzRulesetPolicyNodata, "", "bad.example.com", "*"},
+		{rpzRulesetTriggerName, rpzRulesetPolicyPassthru, "", "good.example.com", "good.example.com"},
+		{rpzRulesetTriggerName, rpzRulesetPolicyPassthru, "", "*.good.example.com", "infoblox-passthru"},
+		{rpzRulesetTriggerName, rpzRulesetPolicySubstitute, "Garden.Example.com.", "bad.example.com", "garden.example.com"},
+		{rpzRulesetTriggerIpAddress, rpzRulesetPolicyNxdomain, "", "10.0.0.1", ""},
+		{rpzRulesetTriggerIpAddress, rpzRulesetPolicyNodata, "", "10.0.0.0/24", "*"},
+		{rpzRulesetTriggerIpAddress, rpzRulesetPolicyPassthru, "", "10.0.0.0/24", "10.0.0.0/24"},
+		{rpzRulesetTriggerIpAddress, rpzRulesetPolicySubstitute, "garden.example.com", "10.0.0.1", "garden.example.com"},
+		{rpzRulesetTriggerClientIpAddress, rpzRulesetPolicyNxdomain, "", "10.0.0.1", ""},
+		{rpzRulesetTriggerClientIpAddress, rpzRulesetPolicyNodata, "", "10.0.0.1", "*"},
+		{rpzRulesetTriggerClientIpAddress, rpzRulesetPolicyPassthru, "", "10.0.0.0/24", "rpz-passthru"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.trigger+"/"+tt.policy+"/"+tt.entry, func(t *testing.T) {
+			m := newRpzRulesetModel(tt.trigger, tt.policy, tt.substituteName)
+			if got := m.canonical(tt.entry); got != tt.expected {
+				t.Errorf("expected canonical %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRpzRulesetNormalizeEntry(t *testing.T) {
+	tests := []struct {
+		trigger   string
+		entry     string
+		expected  string
+		expectErr bool
+	}{
+		{rpzRulesetTriggerName, "Bad.Example.COM.", "bad.example.com", false},
+		{rpzRulesetTriggerName, "*.bad.example.com", "*.bad.example.com", false},
+		{rpzRulesetTriggerName, ".", "", true},
+		{rpzRulesetTriggerIpAddress, "10.0.0.1", "10.0.0.1", false},
+		{rpzRulesetTriggerIpAddress, "10.0.0.1/24", "10.0.0.0/24", false},
+		{rpzRulesetTriggerIpAddress, "2001:DB8::0:1", "2001:db8::1", false},
+		{rpzRulesetTriggerIpAddress, "bad.example.com", "", true},
+		{rpzRulesetTriggerClientIpAddress, "192.168.1.0/24", "192.168.1.0/24", false},
+		{rpzRulesetTriggerClientIpAddress, "192.168.1.256", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.trigger+"/"+tt.entry, func(t *testing.T) {
+			m := newRpzRulesetModel(tt.trigger, rpzRulesetPolicyNxdomain, "")
+			got, err := m.normalizeEntry(tt.entry)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected entry %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeCanonical(t *testing.T) {
+	tests := map[string]string{
+		"":                    "",
+		"*":                   "*",
+		"Garden.Example.com.": "garden.example.com",
+		"rpz-passthru":        "rpz-passthru",
+		"10.0.0.1/24":         "10.0.0.0/24",
+		"2001:DB8::1":         "2001:db8::1",
+	}
+
+	for canonical, expected := range tests {
+		if got := normalizeCanonical(canonical); got != expected {
+			t.Errorf("expected %q for %q, got %q", expected, canonical, got)
+		}
+	}
+}
+
+func TestHashRpzRulesetEntries(t *testing.T) {
+	base := map[string]string{"a.example.com": "garden1.example.com", "b.example.com": "garden1.example.com"}
+
+	if hashRpzRulesetEntries(base) != hashRpzRulesetEntries(map[string]string{"b.example.com": "garden1.example.com", "a.example.com": "garden1.example.com"}) {
+		t.Errorf("expected the hash to be independent of the order of the entries")
+	}
+	if hashRpzRulesetEntries(base) == hashRpzRulesetEntries(map[string]string{"a.example.com": "garden1.example.com", "b.example.com": "garden2.example.com"}) {
+		t.Errorf("expected the hash to change with the canonical name")
+	}
+	if hashRpzRulesetEntries(base) == hashRpzRulesetEntries(map[string]string{"a.example.com": "garden1.example.com"}) {
+		t.Errorf("expected the hash to change with the entries")
+	}
+}
+
+func TestRpzRulesetChanges(t *testing.T) {
+	m := newRpzRulesetModel(rpzRulesetTriggerName, rpzRulesetPolicySubstitute, "garden.example.com")
+	rules := map[string]rpzRulesetRule{
+		"kept.example.com":    {Ref: "record:rpz:cname/kept", Canonical: "garden.example.com"},
+		"drifted.example.com": {Ref: "record:rpz:cname/drifted", Canonical: "other.example.com"},
+		"removed.example.com": {Ref: "record:rpz:cname/removed", Canonical: "garden.example.com"},
+		"foreign.example.com": {Ref: "record:rpz:cname/foreign", Canonical: "elsewhere.example.com"},
+		"claimed.example.com": {Ref: "record:rpz:cname/claimed", Canonical: "garden.example.com"},
+	}
+	owned := map[string]bool{
+		"kept.example.com":    true,
+		"drifted.example.com": true,
+		"removed.example.com": true,
+		"gone.example.com":    true,
+	}
+
+	tests := []struct {
+		name            string
+		entries         []string
+		expected        []utils.WAPIRequest
+		expectedUnowned []string
+	}{
+		{
+			name:    "apply",
+			entries: []string{"drifted.example.com", "kept.example.com", "new.example.com"},
+			expected: []utils.WAPIRequest{
+				{Method: "PUT", Object: "record:rpz:cname/drifted", Data: map[string]any{"canonical": "garden.example.com"}},
+				{Method: "POST", Object: "record:rpz:cname", Data: map[string]any{
+					"name":      "new.example.com.rpz.example.com",
+					"canonical": "garden.example.com",
+					"rp_zone":   "rpz.example.com",
+					"view":      "default",
+				}},
+				{Method: "DELETE", Object: "record:rpz:cname/removed"},
+			},
+		},
+		{
+			name:            "rules not owned",
+			entries:         []string{"kept.example.com", "foreign.example.com", "claimed.example.com"},
+			expected:        []utils.WAPIRequest{{Method: "DELETE", Object: "record:rpz:cname/drifted"}, {Method: "DELETE", Object: "record:rpz:cname/removed"}},
+			expectedUnowned: []string{"claimed.example.com", "foreign.example.com"},
+		},
+		{
+			name: "delete",
+			expected: []utils.WAPIRequest{
+				{Method: "DELETE", Object: "record:rpz:cname/drifted"},
+				{Method: "DELETE", Object: "record:rpz:cname/kept"},
+				{Method: "DELETE", Object: "record:rpz:cname/removed"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests, unowned := rpzRulesetChanges(m, rules, owned, tt.entries)
+			if len(requests) != len(tt.expected) {
+				t.Fatalf("expected %d requests, got %d: %v", len(tt.expected), len(requests), requests)
+			}
+			for _, expected := range tt.expected {
+				if !slices.ContainsFunc(requests, func(request utils.WAPIRequest) bool { return rpzRulesetRequestEqual(request, expected) }) {
+					t.Errorf("expected request %v in %v", expected, requests)
+				}
+			}
+			if !slices.Equal(unowned, tt.expectedUnowned) {
+				t.Errorf("expected entries not owned %v, got %v", tt.expectedUnowned, unowned)
+			}
+		})
+	}
+}
+
+func rpzRulesetRequestEqual(a, b utils.WAPIRequest) bool {
+	if a.Method != b.Method || a.Object != b.Object || len(a.Data) != len(b.Data) {
+		return false
+	}
+	for key, value := range a.Data {
+		if b.Data[key] != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,576 @@
+package rpz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/rpz"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// rpzRulesetBatchSize is the number of rule changes sent in one WAPI multiple request.
+const rpzRulesetBatchSize = 1000
+
+// rpzRulesetOwnedEntriesKey is the private state key of the entries whose rules were created by the rule set.
+const rpzRulesetOwnedEntriesKey = "owned_entries"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RpzRulesetResource{}
+var _ resource.ResourceWithModifyPlan = &RpzRulesetResource{}
+var _ resource.ResourceWithValidateConfig = &RpzRulesetResource{}
+
+func NewRpzRulesetResource() resource.Resource {
+	return &RpzRulesetResource{}
+}
+
+// RpzRulesetResource defines the resource implementation.
+type RpzRulesetResource struct {
+	client *niosclient.APIClient
+}
+
+// rpzRulesetRule is a rule of the trigger type of a rule set that exists in the Response Policy Zone.
+type rpzRulesetRule struct {
+	Ref       string
+	Canonical string
+}
+
+// rpzRulesetRecord is implemented by the RPZ CNAME record types the rules of a rule set are stored as.
+type rpzRulesetRecord interface {
+	GetRef() string
+	GetName() string
+	GetCanonical() string
+}
+
+// privateStateGetter and privateStateSetter are implemented by the private state of the requests and responses.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func (r *RpzRulesetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "rpz_ruleset"
+}
+
+func (r *RpzRulesetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a large set of RPZ CNAME rules that apply the same policy, e.g. a block or allow list, as one resource. " +
+			"Only the rules that differ from the configured entries are created, updated or deleted, in batches. The rule set only manages " +
+			"the rules it created. An entry that already has a rule in the Response Policy Zone which was not created by the rule set, " +
+			"e.g. by an `nios_rpz_record_cname` resource or another rule set, is rejected.",
+		Attributes: RpzRulesetResourceSchemaAttributes,
+	}
+}
+
+func (r *RpzRulesetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RpzRulesetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RpzRulesetModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Policy.IsUnknown() || data.Trigger.IsUnknown() || data.SubstituteName.IsUnknown() {
+		return
+	}
+
+	substitute := data.Policy.ValueString() == rpzRulesetPolicySubstitute
+	switch {
+	case substitute && data.Trigger.ValueString() == rpzRulesetTriggerClientIpAddress:
+		resp.Diagnostics.AddAttributeError(path.Root("policy"), "Invalid Policy", "The SUBSTITUTE policy is not supported for the CLIENT_IP_ADDRESS trigger.")
+	case substitute && data.SubstituteName.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("substitute_name"), "Missing Substitute Name", "substitute_name is required for the SUBSTITUTE policy.")
+	case !substitute && !data.SubstituteName.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("substitute_name"), "Invalid Substitute Name", "substitute_name can only be set for the SUBSTITUTE policy.")
+	}
+
+	if data.Entries.IsUnknown() || data.Entries.IsNull() {
+		return
+	}
+	entries := flex.ExpandFrameworkListString(ctx, data.Entries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := data.normalizeEntries(entries); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("entries"), "Invalid Entry", err.Error())
+	}
+}
+
+func (r *RpzRulesetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data RpzRulesetModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The entries are write-only, so the planned hash is computed from the configuration.
+	// It differs from the hash in the state when the entries or the rules in the zone have changed.
+	if data.Entries.IsUnknown() || data.Trigger.IsUnknown() || data.Policy.IsUnknown() || data.SubstituteName.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entries_hash"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entry_count"), types.Int64Unknown())...)
+		return
+	}
+
+	entries := r.configEntries(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	canonicals := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		canonicals[entry] = data.canonical(entry)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entries_hash"), types.StringValue(hashRpzRulesetEntries(canonicals)))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entry_count"), types.Int64Value(int64(len(entries))))...)
+}
+
+func (r *RpzRulesetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RpzRulesetModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entries"), &data.Entries)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries := r.configEntries(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned := r.apply(ctx, &data, nil, entries, &resp.Diagnostics)
+	setOwnedEntries(ctx, resp.Private, owned, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		if len(owned) > 0 {
+			// Save the partially applied rule set so that its rules are deleted when it is replaced
+			data.EntriesHash = types.StringValue("")
+			data.EntryCount = types.Int64Value(0)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		return
+	}
+
+	r.refresh(ctx, &data, owned, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RpzRulesetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RpzRulesetModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned := getOwnedEntries(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refresh(ctx, &data, owned, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RpzRulesetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RpzRulesetModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entries"), &data.Entries)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries := r.configEntries(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned := getOwnedEntries(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned = r.apply(ctx, &data, owned, entries, &resp.Diagnostics)
+	setOwnedEntries(ctx, resp.Private, owned, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refresh(ctx, &data, owned, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RpzRulesetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RpzRulesetModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned := getOwnedEntries(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := r.listRules(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list RPZ rules of %s, got error: %s", data.RpZone.ValueString(), err))
+		return
+	}
+
+	// Only the rules created by the rule set are deleted
+	requests, _ := rpzRulesetChanges(&data, rules, owned, nil)
+
+	if err := r.send(ctx, requests); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete RPZ rule set, got error: %s", err))
+		return
+	}
+}
+
+// configEntries returns the normalized entries of the configuration.
+func (r *RpzRulesetResource) configEntries(ctx context.Context, data *RpzRulesetModel, diags *diag.Diagnostics) []string {
+	entries, err := data.normalizeEntries(flex.ExpandFrameworkListString(ctx, data.Entries, diags))
+	if err != nil {
+		diags.AddAttributeError(path.Root("entries"), "Invalid Entry", err.Error())
+		return nil
+	}
+	// Write-only values are never stored in the state
+	data.Entries = types.ListNull(types.StringType)
+	return entries
+}
+
+// apply creates, updates and deletes the rules of the zone so that exactly the entries have the policy.
+// owned are the entries whose rules were created by the rule set and nil on create. It returns the entries
+// owned after the changes, which include all entries of the configuration if a batch failed.
+func (r *RpzRulesetResource) apply(ctx context.Context, data *RpzRulesetModel, owned map[string]bool, entries []string, diags *diag.Diagnostics) map[string]bool {
+	rules, err := r.listRules(ctx, data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list RPZ rules of %s, got error: %s", data.RpZone.ValueString(), err))
+		return owned
+	}
+
+	requests, unowned := rpzRulesetChanges(data, rules, owned, entries)
+	if len(unowned) > 0 {
+		diags.AddAttributeError(
+			path.Root("entries"),
+			"Entries Not Managed by Rule Set",
+			fmt.Sprintf("%d entries already have a rule in %s that was not created by this rule set: %s. "+
+				"Delete these rules or remove the entries from the rule set.",
+				len(unowned), data.RpZone.ValueString(), strings.Join(unowned[:min(len(unowned), 10)], ", ")),
+		)
+		return owned
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Applying %d RPZ rule changes to %s", len(requests), data.RpZone.ValueString()))
+	if err := r.send(ctx, requests); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to apply RPZ rule set, got error: %s", err))
+		// Keep the ownership of the rules the sent batches may have created
+		if owned == nil {
+			owned = make(map[string]bool, len(entries))
+		}
+		for _, entry := range entries {
+			owned[entry] = true
+		}
+		return owned
+	}
+
+	owned = make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		owned[entry] = true
+	}
+	return owned
+}
+
+// rpzRulesetChanges returns the requests that make exactly the entries have the policy of the rule set. Only the
+// rules of owned entries are updated or deleted. The entries that have a rule which is not owned are returned
+// sorted instead of adopting the rule.
+func rpzRulesetChanges(data *RpzRulesetModel, rules map[string]rpzRulesetRule, owned map[string]bool, entries []string) ([]utils.WAPIRequest, []string) {
+	desired := make(map[string]bool, len(entries))
+	var requests []utils.WAPIRequest
+	var unowned []string
+	for _, entry := range entries {
+		desired[entry] = true
+		rule, ok := rules[entry]
+		switch {
+		case !ok:
+			requests = append(requests, utils.WAPIRequest{
+				Method: "POST",
+				Object: data.recordType(),
+				Data: map[string]any{
+					"name":      entry + "." + data.RpZone.ValueString(),
+					"canonical": data.canonical(entry),
+					"rp_zone":   data.RpZone.ValueString(),
+					"view":      data.View.ValueString(),
+				},
+			})
+		case !owned[entry]:
+			unowned = append(unowned, entry)
+		case rule.Canonical != data.canonical(entry):
+			requests = append(requests, utils.WAPIRequest{
+				Method: "PUT",
+				Object: rule.Ref,
+				Data:   map[string]any{"canonical": data.canonical(entry)},
+			})
+		}
+	}
+	for _, entry := range slices.Sorted(maps.Keys(owned)) {
+		if rule, ok := rules[entry]; ok && !desired[entry] {
+			requests = append(requests, utils.WAPIRequest{Method: "DELETE", Object: rule.Ref})
+		}
+	}
+	slices.Sort(unowned)
+	return requests, unowned
+}
+
+// refresh sets the hash and count of the owned entries that have a rule in the zone.
+func (r *RpzRulesetResource) refresh(ctx context.Context, data *RpzRulesetModel, owned map[string]bool, diags *diag.Diagnostics) {
+	rules, err := r.listRules(ctx, data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list RPZ rules of %s, got error: %s", data.RpZone.ValueString(), err))
+		return
+	}
+
+	canonicals := make(map[string]string, len(owned))
+	for entry := range owned {
+		if rule, ok := rules[entry]; ok {
+			canonicals[entry] = rule.Canonical
+		}
+	}
+
+	data.EntriesHash = types.StringValue(hashRpzRulesetEntries(canonicals))
+	data.EntryCount = types.Int64Value(int64(len(canonicals)))
+}
+
+// listRules returns the rules of the trigger type of the rule set in the zone by their normalized entry.
+func (r *RpzRulesetResource) listRules(ctx context.Context, data *RpzRulesetModel) (map[string]rpzRulesetRule, error) {
+	filters := map[string]any{
+		"zone": data.RpZone.ValueString(),
+		"view": data.View.ValueString(),
+	}
+
+	var records []rpzRulesetRecord
+	var err error
+	switch data.Trigger.ValueString() {
+	case rpzRulesetTriggerName:
+		records, err = readRpzRulesetRecords(func(pageID string, maxResults int32) ([]rpz.RecordRpzCname, string, error) {
+			request := r.client.RPZAPI.
+				RecordRpzCnameAPI.
+				List(ctx).
+				Filters(filters).
+				ReturnAsObject(1).
+				ReturnFields("canonical,name").
+				Paging(1).
+				MaxResults(maxResults).
+				ProxySearch(config.GetProxySearch())
+			if pageID != "" {
+				request = request.PageId(pageID)
+			}
+			apiRes, _, err := request.Execute()
+			if err != nil {
+				return nil, "", err
+			}
+			return apiRes.ListRecordRpzCnameResponseObject.GetResult(), nextPageID(apiRes.ListRecordRpzCnameResponseObject.AdditionalProperties), nil
+		})
+	case rpzRulesetTriggerIpAddress:
+		records, err = readRpzRulesetRecords(func(pageID string, maxResults int32) ([]rpz.RecordRpzCnameIpaddress, string, error) {
+			request := r.client.RPZAPI.
+				RecordRpzCnameIpaddressAPI.
+				List(ctx).
+				Filters(filters).
+				ReturnAsObject(1).
+				ReturnFields("canonical,name").
+				Paging(1).
+				MaxResults(maxResults).
+				ProxySearch(config.GetProxySearch())
+			if pageID != "" {
+				request = request.PageId(pageID)
+			}
+			apiRes, _, err := request.Execute()
+			if err != nil {
+				return nil, "", err
+			}
+			return apiRes.ListRecordRpzCnameIpaddressResponseObject.GetResult(), nextPageID(apiRes.ListRecordRpzCnameIpaddressResponseObject.AdditionalProperties), nil
+		})
+	case rpzRulesetTriggerClientIpAddress:
+		records, err = readRpzRulesetRecords(func(pageID string, maxResults int32) ([]rpz.RecordRpzCnameClientipaddress, string, error) {
+			request := r.client.RPZAPI.
+				RecordRpzCnameClientipaddressAPI.
+				List(ctx).
+				Filters(filters).
+				ReturnAsObject(1).
+				ReturnFields("canonical,name").
+				Paging(1).
+				MaxResults(maxResults).
+				ProxySearch(config.GetProxySearch())
+			if pageID != "" {
+				request = request.PageId(pageID)
+			}
+			apiRes, _, err := request.Execute()
+			if err != nil {
+				return nil, "", err
+			}
+			return apiRes.ListRecordRpzCnameClientipaddressResponseObject.GetResult(), nextPageID(apiRes.ListRecordRpzCnameClientipaddressResponseObject.AdditionalProperties), nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(map[string]rpzRulesetRule, len(records))
+	for _, record := range records {
+		name := strings.TrimSuffix(record.GetName(), "."+data.RpZone.ValueString())
+		entry, err := data.normalizeEntry(name)
+		if err != nil {
+			entry = name
+		}
+		rules[entry] = rpzRulesetRule{
+			Ref:       record.GetRef(),
+			Canonical: normalizeCanonical(record.GetCanonical()),
+		}
+	}
+	return rules, nil
+}
+
+// readRpzRulesetRecords reads all pages of RPZ CNAME records of one type.
+func readRpzRulesetRecords[T any, P interface {
+	*T
+	rpzRulesetRecord
+}](read func(pageID string, maxResults int32) ([]T, string, error)) ([]rpzRulesetRecord, error) {
+	results, err := utils.ReadWithPages(read)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]rpzRulesetRecord, 0, len(results))
+	for i := range results {
+		records = append(records, P(&results[i]))
+	}
+	return records, nil
+}
+
+// nextPageID returns the id of the next page of a paged WAPI response.
+func nextPageID(additionalProperties map[string]any) string {
+	npId, _ := additionalProperties["next_page_id"].(string)
+	return npId
+}
+
+// getOwnedEntries returns the entries whose rules were created by the rule set.
+func getOwnedEntries(ctx context.Context, private privateStateGetter, diags *diag.Diagnostics) map[string]bool {
+	value, d := private.GetKey(ctx, rpzRulesetOwnedEntriesKey)
+	diags.Append(d...)
+	owned := make(map[string]bool)
+	if len(value) == 0 {
+		return owned
+	}
+	var entries []string
+	if err := json.Unmarshal(value, &entries); err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to read the entries of the RPZ rule set from the private state, got error: %s", err))
+		return owned
+	}
+	for _, entry := range entries {
+		owned[entry] = true
+	}
+	return owned
+}
+
+// setOwnedEntries stores the entries whose rules were created by the rule set.
+func setOwnedEntries(ctx context.Context, private privateStateSetter, owned map[string]bool, diags *diag.Diagnostics) {
+	value, err := json.Marshal(slices.Sorted(maps.Keys(owned)))
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to store the entries of the RPZ rule set in the private state, got error: %s", err))
+		return
+	}
+	diags.Append(private.SetKey(ctx, rpzRulesetOwnedEntriesKey, value)...)
+}
+
+// send applies the changes in batches of WAPI multiple requests.
+func (r *RpzRulesetResource) send(ctx context.Context, requests []utils.WAPIRequest) error {
+	for batch := range slices.Chunk(requests, rpzRulesetBatchSize) {
+		err := utils.CallWAPIRequest(
+			ctx,
+			r.client.RPZAPI.Cfg.NIOSHostURL,
+			r.client.RPZAPI.Cfg.NIOSUsername,
+			r.client.RPZAPI.Cfg.NIOSPassword,
+			batch,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
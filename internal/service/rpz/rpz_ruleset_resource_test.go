@@ -0,0 +1,344 @@
+package rpz_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+func TestAccRpzRulesetResource_basic(t *testing.T) {
+	var resourceName = "nios_rpz_ruleset.test"
+	rpZone := acctest.RandomNameWithPrefix("test-zone") + ".com"
+	entries := []string{"bad1.example.com", "bad2.example.com", "*.bad3.example.com"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRpzRulesetBasicConfig(rpZone, "NAME", "NXDOMAIN", entries),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRpzRulesetEntries(context.Background(), resourceName, "record:rpz:cname", entries),
+					resource.TestCheckResourceAttr(resourceName, "rp_zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "trigger", "NAME"),
+					resource.TestCheckResourceAttr(resourceName, "policy", "NXDOMAIN"),
+					resource.TestCheckResourceAttr(resourceName, "entry_count", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "entries_hash"),
+					resource.TestCheckNoResourceAttr(resourceName, "entries"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRpzRulesetResource_disappears(t *testing.T) {
+	resourceName := "nios_rpz_ruleset.test"
+	rpZone := acctest.RandomNameWithPrefix("test-zone") + ".com"
+	entries := []string{"bad1.example.com", "bad2.example.com"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRpzRulesetBasicConfig(rpZone, "NAME", "NXDOMAIN", entries),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRpzRulesetEntries(context.Background(), resourceName, "record:rpz:cname", entries),
+					testAccCheckRpzRulesetRuleDisappears(context.Background(), resourceName, "record:rpz:cname", "bad1.example.com"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRpzRulesetResource_Entries(t *testing.T) {
+	var resourceName = "nios_rpz_ruleset.test"
+	rpZone := acctest.RandomNameWithPrefix("test-zone") + ".com"
+	entries1 := []string{"bad1.example.com", "bad2.example.com", "bad3.example.com"}
+	entries2 := []string{"bad2.example.com", "bad3.example.com", "bad4.example.com", "bad5.example.com"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRpzRulesetBasicConfig(rpZone, "NAME", "NXDOMAIN", entries1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRpzRulesetEntries(context.Background(), resourceName, "record:rpz:cname", entries1),
+					resource.TestCheckResourceAttr(resourceName, "entry_count", "3"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRpzRulesetBasicConfig(rpZone, "NAME", "NXDOMAIN", entries2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRpzRulesetEntries(context.Background(), resourceName, "record:rpz:cname", entries2),
+					resource.TestCheckResourceAttr(resourceName, "entry_count", "4"),
+				),
+			},
+			// Same entries in a different order and case do not change anything
+			{
+				Config:   testAccRpzRulesetBasicConfig(rpZone, "NAME", "NXDOMAIN", []string{"BAD5.example.com.", "bad4.example.com", "bad3.example.com", "bad2.example.com", "bad2.example.com"}),
+				PlanOnly: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRpzRulesetResource_Policy(t *testing.T) {
+	var resourceName = "nios_rpz_ruleset.test"
+	rpZone := acctest.RandomNameWithPrefix("test-zone") + ".com"
+	entries := []string{"site1.example.com", "site2.example.com"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRpzRulesetBasicConfig(rpZone, "NAME", "NXDOMAIN", entries),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy", "NXDOMAIN"),
+					resource.TestCheckResourceAttr(resourceName, "entry_count", "2"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRpzRulesetBasicConfig(rpZone, "NAME", "NODATA", entries),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy", "NODATA"),
+					resource.TestCheckResourceAttr(resourceName, "entry_count", "2"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRpzRulesetBasicConfig(rpZone, "NAME", "PASSTHRU", entries),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRpzRulesetEntries(context.Background(), resourceName, "record:rpz:cname", entries),
+					resource.TestCheckResourceAttr(resourceName, "policy", "PASSTHRU"),
+					resource.TestCheckResourceAttr(resourceName, "entry_count", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRpzRulesetResource_SubstituteName(t *testing.T) {
+	var resourceName = "nios_rpz_ruleset.test_substitute_name"
+	rpZone := acctest.RandomNameWithPrefix("test-zone") + ".com"
+	entries := []string{"site1.example.com", "site2.example.com"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRpzRulesetSubstituteName(rpZone, "walled-garden.example.com", entries),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRpzRulesetEntries(context.Background(), resourceName, "record:rpz:cname", entries),
+					resource.TestCheckResourceAttr(resourceName, "substitute_name", "walled-garden.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRpzRulesetSubstituteName(rpZone, "blocked.example.com", entries),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRpzRulesetEntries(context.Background(), resourceName, "record:rpz:cname", entries),
+					resource.TestCheckResourceAttr(resourceName, "substitute_name", "blocked.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRpzRulesetResource_Trigger(t *testing.T) {
+	var resourceName = "nios_rpz_ruleset.test"
+	rpZone := acctest.RandomNameWithPrefix("test-zone") + ".com"
+	ipEntries := []string{"11.0.0.1", "11.0.1.0/24"}
+	clientIpEntries := []string{"12.0.0.1", "12.0.1.0/24"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRpzRulesetBasicConfig(rpZone, "IP_ADDRESS", "NXDOMAIN", ipEntries),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRpzRulesetEntries(context.Background(), resourceName, "record:rpz:cname:ipaddress", ipEntries),
+					resource.TestCheckResourceAttr(resourceName, "trigger", "IP_ADDRESS"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRpzRulesetBasicConfig(rpZone, "CLIENT_IP_ADDRESS", "PASSTHRU", clientIpEntries),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRpzRulesetEntries(context.Background(), resourceName, "record:rpz:cname:clientipaddress", clientIpEntries),
+					resource.TestCheckResourceAttr(resourceName, "trigger", "CLIENT_IP_ADDRESS"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRpzRulesetResource_RulesNotOwned(t *testing.T) {
+	var resourceName = "nios_rpz_ruleset.test"
+	rpZone := acctest.RandomNameWithPrefix("test-zone") + ".com"
+	entries := []string{"bad1.example.com", "bad2.example.com"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The rule with the same policy that was not created by the rule set is kept
+			{
+				Config: testAccRpzRulesetWithRecordCname(rpZone, entries),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRpzRulesetEntries(context.Background(), resourceName, "record:rpz:cname", append(slices.Clone(entries), "foreign.example.com")),
+					resource.TestCheckResourceAttr(resourceName, "entry_count", "2"),
+				),
+			},
+			// The rule that was not created by the rule set is not adopted
+			{
+				Config:      testAccRpzRulesetWithRecordCname(rpZone, append(slices.Clone(entries), "foreign.example.com")),
+				ExpectError: regexp.MustCompile("Entries Not Managed by Rule Set"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckRpzRulesetEntries verifies that exactly the entries have a rule of the record type in the zone of the rule set.
+func testAccCheckRpzRulesetEntries(ctx context.Context, resourceName, recordType string, entries []string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		names, err := testAccRpzRulesetRuleNames(ctx, state, resourceName, recordType)
+		if err != nil {
+			return err
+		}
+		slices.Sort(names)
+		expected := slices.Clone(entries)
+		slices.Sort(expected)
+		if !slices.Equal(names, expected) {
+			return fmt.Errorf("expected rules for %v, got %v", expected, names)
+		}
+		return nil
+	}
+}
+
+func testAccCheckRpzRulesetRuleDisappears(ctx context.Context, resourceName, recordType, entry string) resource.TestCheckFunc {
+	// Delete a rule of the rule set externally to verify disappears test
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		refs, err := utils.ListWAPIObjectRefs(
+			ctx,
+			acctest.NIOSClient.RPZAPI.Cfg.NIOSHostURL,
+			acctest.NIOSClient.RPZAPI.Cfg.NIOSUsername,
+			acctest.NIOSClient.RPZAPI.Cfg.NIOSPassword,
+			recordType,
+			map[string]string{"name": entry + "." + rs.Primary.Attributes["rp_zone"], "view": rs.Primary.Attributes["view"]},
+		)
+		if err != nil {
+			return err
+		}
+		if len(refs) == 0 {
+			return fmt.Errorf("no rule found for %s", entry)
+		}
+		_, err = acctest.NIOSClient.RPZAPI.
+			RecordRpzCnameAPI.
+			Delete(ctx, utils.ExtractResourceRef(refs[0])).
+			Execute()
+		return err
+	}
+}
+
+func testAccRpzRulesetRuleNames(ctx context.Context, state *terraform.State, resourceName, recordType string) ([]string, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("not found: %s", resourceName)
+	}
+	rpZone := rs.Primary.Attributes["rp_zone"]
+	apiRes, _, err := acctest.NIOSClient.RPZAPI.
+		AllrpzrecordsAPI.
+		List(ctx).
+		Filters(map[string]any{"zone": rpZone, "view": rs.Primary.Attributes["view"]}).
+		ReturnFields("name,type").
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, record := range apiRes.ListAllrpzrecordsResponseObject.GetResult() {
+		if record.GetType() == recordType {
+			names = append(names, strings.TrimSuffix(record.GetName(), "."+rpZone))
+		}
+	}
+	return names, nil
+}
+
+func testAccRpzRulesetBasicConfig(rpZone, trigger, policy string, entries []string) string {
+	config := fmt.Sprintf(`
+resource "nios_rpz_ruleset" "test" {
+	rp_zone = nios_dns_zone_rp.test.fqdn
+	trigger = %q
+	policy = %q
+	entries = %s
+}
+`, trigger, policy, utils.ConvertStringSliceToHCL(entries))
+	return strings.Join([]string{testAccBaseWithZone(rpZone, ""), config}, "")
+}
+
+func testAccRpzRulesetSubstituteName(rpZone, substituteName string, entries []string) string {
+	config := fmt.Sprintf(`
+resource "nios_rpz_ruleset" "test_substitute_name" {
+	rp_zone = nios_dns_zone_rp.test.fqdn
+	trigger = "NAME"
+	policy = "SUBSTITUTE"
+	substitute_name = %q
+	entries = %s
+}
+`, substituteName, utils.ConvertStringSliceToHCL(entries))
+	return strings.Join([]string{testAccBaseWithZone(rpZone, ""), config}, "")
+}
+
+func testAccRpzRulesetWithRecordCname(rpZone string, entries []string) string {
+	config := fmt.Sprintf(`
+resource "nios_rpz_record_cname" "foreign" {
+	name = "foreign.example.com.${nios_dns_zone_rp.test.fqdn}"
+	canonical = ""
+	rp_zone = nios_dns_zone_rp.test.fqdn
+}
+
+resource "nios_rpz_ruleset" "test" {
+	rp_zone = nios_dns_zone_rp.test.fqdn
+	trigger = "NAME"
+	policy = "NXDOMAIN"
+	entries = %s
+	depends_on = [nios_rpz_record_cname.foreign]
+}
+`, utils.ConvertStringSliceToHCL(entries))
+	return strings.Join([]string{testAccBaseWithZone(rpZone, ""), config}, "")
+}
@@ -0,0 +1,508 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForAdAuthService = "ad_domain,comment,disabled,domain_controllers,name,nested_group_querying,timeout"
+
+func TestAccAdAuthServiceResource_basic(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "10.0.0.10",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "corp.example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "ad_domain", "corp.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.fqdn_or_ip", "10.0.0.10"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "nested_group_querying", "false"),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.auth_port", "389"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.encryption", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.use_mgmt_port", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_disappears(t *testing.T) {
+	resourceName := "nios_security_ad_auth_service.test"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "10.0.0.10",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdAuthServiceDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "corp.example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					testAccCheckAdAuthServiceDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_Import(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "10.0.0.10",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "corp.example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccAdAuthServiceImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_AdDomain(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "10.0.0.10",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "corp.example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ad_domain", "corp.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "ad.example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ad_domain", "ad.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_Comment(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test_comment"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "10.0.0.10",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceComment(name, "corp.example.com", domainControllers, "This is a comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a comment"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceComment(name, "corp.example.com", domainControllers, "This is an updated comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated comment"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_Disabled(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test_disabled"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "10.0.0.10",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceDisabled(name, "corp.example.com", domainControllers, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceDisabled(name, "corp.example.com", domainControllers, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_DomainControllers(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "10.0.0.10",
+		},
+	}
+	domainControllersUpdated := []map[string]any{
+		{
+			"fqdn_or_ip": "dc1.corp.example.com",
+			"auth_port":  636,
+			"encryption": "SSL",
+			"comment":    "Primary domain controller",
+		},
+		{
+			"fqdn_or_ip": "10.0.0.11",
+			"disabled":   true,
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "corp.example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.fqdn_or_ip", "10.0.0.10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "corp.example.com", domainControllersUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.fqdn_or_ip", "dc1.corp.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.auth_port", "636"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.encryption", "SSL"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.comment", "Primary domain controller"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.1.fqdn_or_ip", "10.0.0.11"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.1.disabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_Name(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	nameUpdated := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "10.0.0.10",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "corp.example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(nameUpdated, "corp.example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", nameUpdated),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_NestedGroupQuerying(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test_nested_group_querying"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "10.0.0.10",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceNestedGroupQuerying(name, "corp.example.com", domainControllers, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "nested_group_querying", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceNestedGroupQuerying(name, "corp.example.com", domainControllers, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "nested_group_querying", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_Timeout(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test_timeout"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "10.0.0.10",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceTimeout(name, "corp.example.com", domainControllers, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceTimeout(name, "corp.example.com", domainControllers, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckAdAuthServiceExists(ctx context.Context, resourceName string, v *security.AdAuthService) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			AdAuthServiceAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForAdAuthService).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetAdAuthServiceResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetAdAuthServiceResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckAdAuthServiceDestroy(ctx context.Context, v *security.AdAuthService) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.SecurityAPI.
+			AdAuthServiceAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForAdAuthService).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckAdAuthServiceDisappears(ctx context.Context, v *security.AdAuthService) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.SecurityAPI.
+			AdAuthServiceAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccAdAuthServiceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccAdAuthServiceBasicConfig(name, adDomain string, domainControllers []map[string]any) string {
+	domainControllersStr := utils.ConvertSliceOfMapsToHCL(domainControllers)
+	return fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test" {
+	name = %q
+	ad_domain = %q
+	domain_controllers = %s
+}
+`, name, adDomain, domainControllersStr)
+}
+
+func testAccAdAuthServiceComment(name, adDomain string, domainControllers []map[string]any, comment string) string {
+	domainControllersStr := utils.ConvertSliceOfMapsToHCL(domainControllers)
+	return fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test_comment" {
+	name = %q
+	ad_domain = %q
+	domain_controllers = %s
+	comment = %q
+}
+`, name, adDomain, domainControllersStr, comment)
+}
+
+func testAccAdAuthServiceDisabled(name, adDomain string, domainControllers []map[string]any, disabled bool) string {
+	domainControllersStr := utils.ConvertSliceOfMapsToHCL(domainControllers)
+	return fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test_disabled" {
+	name = %q
+	ad_domain = %q
+	domain_controllers = %s
+	disabled = %t
+}
+`, name, adDomain, domainControllersStr, disabled)
+}
+
+func testAccAdAuthServiceNestedGroupQuerying(name, adDomain string, domainControllers []map[string]any, nestedGroupQuerying bool) string {
+	domainControllersStr := utils.ConvertSliceOfMapsToHCL(domainControllers)
+	return fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test_nested_group_querying" {
+	name = %q
+	ad_domain = %q
+	domain_controllers = %s
+	nested_group_querying = %t
+}
+`, name, adDomain, domainControllersStr, nestedGroupQuerying)
+}
+
+func testAccAdAuthServiceTimeout(name, adDomain string, domainControllers []map[string]any, timeout int) string {
+	domainControllersStr := utils.ConvertSliceOfMapsToHCL(domainControllers)
+	return fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test_timeout" {
+	name = %q
+	ad_domain = %q
+	domain_controllers = %s
+	timeout = %d
+}
+`, name, adDomain, domainControllersStr, timeout)
+}
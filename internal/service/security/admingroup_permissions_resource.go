@@ -0,0 +1,475 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AdmingroupPermissionsResource{}
+var _ resource.ResourceWithImportState = &AdmingroupPermissionsResource{}
+var _ resource.ResourceWithValidateConfig = &AdmingroupPermissionsResource{}
+
+func NewAdmingroupPermissionsResource() resource.Resource {
+	return &AdmingroupPermissionsResource{}
+}
+
+// AdmingroupPermissionsResource defines the resource implementation.
+type AdmingroupPermissionsResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *AdmingroupPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "security_admingroup_permissions"
+}
+
+func (r *AdmingroupPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete set of permissions of an admin group or admin role. Permissions granted outside of Terraform are reported as drift and removed on the next apply. Do not combine with `nios_security_permission` resources for the same group or role.",
+		Attributes:          AdmingroupPermissionsResourceSchemaAttributes,
+	}
+}
+
+func (r *AdmingroupPermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AdmingroupPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AdmingroupPermissionsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdmingroupPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AdmingroupPermissionsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior []AdmingroupPermissionsPermissionsModel
+	if !data.Permissions.IsNull() && !data.Permissions.IsUnknown() {
+		resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &prior, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	current, err := r.listPermissions(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permissions of %s, got error: %s", permissionOwner(&data), err))
+		return
+	}
+
+	if len(current) == 0 {
+		// No permissions left, the group or role was removed or its permissions were revoked
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.FlattenPermissions(ctx, prior, current, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdmingroupPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AdmingroupPermissionsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdmingroupPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AdmingroupPermissionsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.listPermissions(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permissions of %s, got error: %s", permissionOwner(&data), err))
+		return
+	}
+
+	for _, perm := range current {
+		if err := r.deletePermission(ctx, perm.GetRef()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Permission, got error: %s", err))
+			return
+		}
+	}
+}
+
+func (r *AdmingroupPermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AdmingroupPermissionsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Permissions.IsNull() || data.Permissions.IsUnknown() {
+		return
+	}
+
+	var permissions []AdmingroupPermissionsPermissionsModel
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, p := range permissions {
+		if p.Object.IsUnknown() || p.ObjectName.IsUnknown() || p.ResourceType.IsUnknown() {
+			continue
+		}
+		if p.Object.IsNull() && p.ObjectName.IsNull() && p.ResourceType.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("permissions").AtListIndex(i),
+				"Invalid Permission",
+				"Each permission must set object, object_name or resource_type.",
+			)
+		}
+		if !p.ObjectView.IsNull() && !p.ObjectView.IsUnknown() && !p.ObjectType.IsUnknown() {
+			if fields, ok := permissionObjectLookupFields[p.ObjectType.ValueString()]; ok && fields[1] == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("permissions").AtListIndex(i).AtName("object_view"),
+					"Invalid Permission",
+					fmt.Sprintf("object_view cannot be set for object type %s.", p.ObjectType.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (r *AdmingroupPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is the admin group name, or the admin role name prefixed with "role:"
+	if role, ok := strings.CutPrefix(req.ID, "role:"); ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), role)...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), req.ID)...)
+}
+
+// apply makes the permissions of the group or role on the Grid equal to the planned permissions:
+// missing permissions are created, changed ones are updated and all others are removed.
+func (r *AdmingroupPermissionsResource) apply(ctx context.Context, data *AdmingroupPermissionsModel, diags *diag.Diagnostics) {
+	var desired []AdmingroupPermissionsPermissionsModel
+	diags.Append(data.Permissions.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Resolve objects addressed by name to their references
+	seen := map[string]int{}
+	for i := range desired {
+		p := &desired[i]
+		if !p.ObjectName.IsNull() {
+			ref, err := r.lookupObject(ctx, p)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("permissions").AtListIndex(i),
+					"Object Lookup Failed",
+					err.Error(),
+				)
+				return
+			}
+			p.Object = types.StringValue(ref)
+		} else if p.Object.IsUnknown() {
+			p.Object = types.StringNull()
+		}
+
+		key := permissionKey(p.Object.ValueString(), p.ResourceType.ValueString())
+		if j, ok := seen[key]; ok {
+			diags.AddAttributeError(
+				path.Root("permissions").AtListIndex(i),
+				"Duplicate Permission",
+				fmt.Sprintf("The permission for the same object and resource type is already defined at index %d.", j),
+			)
+			return
+		}
+		seen[key] = i
+	}
+
+	current, err := r.listPermissions(ctx, data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read permissions of %s, got error: %s", permissionOwner(data), err))
+		return
+	}
+
+	existing := map[string]security.Permission{}
+	for _, perm := range current {
+		existing[permissionKey(perm.GetObject(), perm.GetResourceType())] = perm
+	}
+
+	// Remove permissions that are not part of the plan first, so the group never holds more than planned
+	for key, perm := range existing {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		tflog.Info(ctx, "Removing permission not defined in configuration", map[string]any{
+			"object":        perm.GetObject(),
+			"resource_type": perm.GetResourceType(),
+			"permission":    perm.GetPermission(),
+		})
+		if err := r.deletePermission(ctx, perm.GetRef()); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete Permission, got error: %s", err))
+			return
+		}
+	}
+
+	for i := range desired {
+		p := &desired[i]
+		payload := p.Expand(ctx, data.Group, data.Role, diags)
+		if diags.HasError() {
+			return
+		}
+
+		var res *security.Permission
+		if perm, ok := existing[permissionKey(p.Object.ValueString(), p.ResourceType.ValueString())]; ok {
+			if perm.GetPermission() == p.Permission.ValueString() {
+				res = &perm
+			} else {
+				res, err = r.updatePermission(ctx, perm.GetRef(), payload)
+			}
+		} else {
+			res, err = r.createPermission(ctx, payload)
+		}
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("permissions").AtListIndex(i),
+				"Client Error",
+				fmt.Sprintf("Unable to set Permission, got error: %s", err),
+			)
+			return
+		}
+		p.Flatten(ctx, res, diags)
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AdmingroupPermissionsPermissionsAttrTypes}, desired)
+	diags.Append(d...)
+	data.Permissions = list
+}
+
+// lookupObject returns the reference of the object addressed by object_type, object_name and object_view.
+func (r *AdmingroupPermissionsResource) lookupObject(ctx context.Context, p *AdmingroupPermissionsPermissionsModel) (string, error) {
+	objectType := p.ObjectType.ValueString()
+	fields := permissionObjectLookupFields[objectType]
+
+	filters := map[string]string{
+		fields[0]: p.ObjectName.ValueString(),
+	}
+	if !p.ObjectView.IsNull() && fields[1] != "" {
+		filters[fields[1]] = p.ObjectView.ValueString()
+	}
+
+	refs, err := utils.ListWAPIObjectRefs(
+		ctx,
+		r.client.SecurityAPI.Cfg.NIOSHostURL,
+		r.client.SecurityAPI.Cfg.NIOSUsername,
+		r.client.SecurityAPI.Cfg.NIOSPassword,
+		objectType,
+		filters,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(refs) {
+	case 0:
+		return "", fmt.Errorf("no %s object named %q found", objectType, p.ObjectName.ValueString())
+	case 1:
+		return refs[0], nil
+	default:
+		return "", fmt.Errorf("%d %s objects named %q found, set object_view to select one", len(refs), objectType, p.ObjectName.ValueString())
+	}
+}
+
+func (r *AdmingroupPermissionsResource) listPermissions(ctx context.Context, data *AdmingroupPermissionsModel) ([]security.Permission, error) {
+	filters := map[string]interface{}{}
+	if !data.Group.IsNull() {
+		filters["group"] = data.Group.ValueString()
+	} else {
+		filters["role"] = data.Role.ValueString()
+	}
+
+	return utils.ReadWithPages(func(pageID string, maxResults int32) ([]security.Permission, string, error) {
+		request := r.client.SecurityAPI.
+			PermissionAPI.
+			List(ctx).
+			Filters(filters).
+			ReturnFieldsPlus(readableAttributesForPermission).
+			ReturnAsObject(1).
+			Paging(1).
+			MaxResults(maxResults).
+			ProxySearch(config.GetProxySearch())
+
+		if pageID != "" {
+			request = request.PageId(pageID)
+		}
+
+		apiRes, _, err := request.Execute()
+		if err != nil {
+			return nil, "", err
+		}
+
+		var nextPageID string
+		if npId, ok := apiRes.ListPermissionResponseObject.AdditionalProperties["next_page_id"].(string); ok {
+			nextPageID = npId
+		}
+		return apiRes.ListPermissionResponseObject.GetResult(), nextPageID, nil
+	})
+}
+
+func (r *AdmingroupPermissionsResource) createPermission(ctx context.Context, payload *security.Permission) (*security.Permission, error) {
+	var apiRes *security.CreatePermissionResponse
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.SecurityAPI.
+			PermissionAPI.
+			Create(ctx).
+			Permission(*payload).
+			ReturnFieldsPlus(readableAttributesForPermission).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := apiRes.CreatePermissionResponseAsObject.GetResult()
+	return &res, nil
+}
+
+func (r *AdmingroupPermissionsResource) updatePermission(ctx context.Context, ref string, payload *security.Permission) (*security.Permission, error) {
+	var apiRes *security.UpdatePermissionResponse
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.SecurityAPI.
+			PermissionAPI.
+			Update(ctx, utils.ExtractResourceRef(ref)).
+			Permission(security.Permission{Permission: payload.Permission}).
+			ReturnFieldsPlus(readableAttributesForPermission).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := apiRes.UpdatePermissionResponseAsObject.GetResult()
+	return &res, nil
+}
+
+func (r *AdmingroupPermissionsResource) deletePermission(ctx context.Context, ref string) error {
+	return retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		httpRes, callErr := r.client.SecurityAPI.
+			PermissionAPI.
+			Delete(ctx, utils.ExtractResourceRef(ref)).
+			Execute()
+
+		if httpRes != nil {
+			if httpRes.StatusCode == http.StatusNotFound {
+				return 0, nil
+			}
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+}
+
+// permissionKey identifies a permission of a group or role by its object and resource type.
+// Only the type and ID part of the object reference is compared, as the name part can change.
+func permissionKey(object, resourceType string) string {
+	object, _, _ = strings.Cut(object, ":")
+	return object + "|" + resourceType
+}
+
+func permissionOwner(data *AdmingroupPermissionsModel) string {
+	if !data.Group.IsNull() {
+		return fmt.Sprintf("admin group %s", data.Group.ValueString())
+	}
+	return fmt.Sprintf("admin role %s", data.Role.ValueString())
+}
@@ -0,0 +1,211 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+func TestAccAdmingroupPermissionsResource_basic(t *testing.T) {
+	var resourceName = "nios_security_admingroup_permissions.test"
+	group := acctest.RandomNameWithPrefix("admin-group")
+	view := acctest.RandomNameWithPrefix("tf-test-view-")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdmingroupPermissionsBasicConfig(group, view, "READ"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdmingroupPermissionsCount(context.Background(), group, 2),
+					resource.TestCheckResourceAttr(resourceName, "group", group),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.0.resource_type", "NETWORK_VIEW"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.0.permission", "READ"),
+					resource.TestCheckResourceAttrPair(resourceName, "permissions.1.object", "nios_dns_view.test", "ref"),
+					resource.TestCheckResourceAttrSet(resourceName, "permissions.1.ref"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdmingroupPermissionsBasicConfig(group, view, "WRITE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdmingroupPermissionsCount(context.Background(), group, 2),
+					resource.TestCheckResourceAttr(resourceName, "permissions.1.permission", "WRITE"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdmingroupPermissionsResource_Drift(t *testing.T) {
+	var resourceName = "nios_security_admingroup_permissions.test"
+	group := acctest.RandomNameWithPrefix("admin-group")
+	view := acctest.RandomNameWithPrefix("tf-test-view-")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdmingroupPermissionsBasicConfig(group, view, "READ"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					// Grant a permission outside of Terraform
+					testAccAdmingroupPermissionsGrant(context.Background(), group, "HOST", "WRITE"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// The extra permission is removed on the next apply
+			{
+				Config: testAccAdmingroupPermissionsBasicConfig(group, view, "READ"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdmingroupPermissionsCount(context.Background(), group, 2),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAdmingroupPermissionsResource_ObjectName(t *testing.T) {
+	var resourceName = "nios_security_admingroup_permissions.test_object_name"
+	group := acctest.RandomNameWithPrefix("admin-group")
+	view := acctest.RandomNameWithPrefix("tf-test-view-")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdmingroupPermissionsObjectName(group, view),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.0.object_type", "view"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.0.object_name", view),
+					resource.TestCheckResourceAttrPair(resourceName, "permissions.0.object", "nios_dns_view.test", "ref"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdmingroupPermissionsResource_Import(t *testing.T) {
+	var resourceName = "nios_security_admingroup_permissions.test"
+	group := acctest.RandomNameWithPrefix("admin-group")
+	view := acctest.RandomNameWithPrefix("tf-test-view-")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdmingroupPermissionsBasicConfig(group, view, "READ"),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        group,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group",
+			},
+		},
+	})
+}
+
+func testAccCheckAdmingroupPermissionsCount(ctx context.Context, group string, count int) resource.TestCheckFunc {
+	// Verify the group holds exactly the expected number of permissions
+	return func(state *terraform.State) error {
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			PermissionAPI.
+			List(ctx).
+			Filters(map[string]interface{}{"group": group}).
+			ReturnFieldsPlus(readableAttributesForPermission).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if n := len(apiRes.ListPermissionResponseObject.GetResult()); n != count {
+			return fmt.Errorf("expected %d permissions for group %s, got %d", count, group, n)
+		}
+		return nil
+	}
+}
+
+func testAccAdmingroupPermissionsGrant(ctx context.Context, group, resourceType, permission string) resource.TestCheckFunc {
+	// Create a permission outside of Terraform to verify drift detection
+	return func(state *terraform.State) error {
+		_, _, err := acctest.NIOSClient.SecurityAPI.
+			PermissionAPI.
+			Create(ctx).
+			Permission(security.Permission{
+				Group:        utils.Ptr(group),
+				ResourceType: utils.Ptr(resourceType),
+				Permission:   utils.Ptr(permission),
+			}).
+			ReturnAsObject(1).
+			Execute()
+		return err
+	}
+}
+
+func testAccAdmingroupPermissionsParentConfig(group, view string) string {
+	return fmt.Sprintf(`
+resource "nios_security_admin_group" "test" {
+	name = %q
+}
+
+resource "nios_dns_view" "test" {
+	name = %q
+}
+`, group, view)
+}
+
+func testAccAdmingroupPermissionsBasicConfig(group, view, permission string) string {
+	config := fmt.Sprintf(`
+resource "nios_security_admingroup_permissions" "test" {
+	group = nios_security_admin_group.test.name
+	permissions = [
+		{
+			resource_type = "NETWORK_VIEW"
+			permission    = "READ"
+		},
+		{
+			object        = nios_dns_view.test.ref
+			resource_type = "ZONE"
+			permission    = %q
+		},
+	]
+}
+`, permission)
+	return testAccAdmingroupPermissionsParentConfig(group, view) + config
+}
+
+func testAccAdmingroupPermissionsObjectName(group, view string) string {
+	config := `
+resource "nios_security_admingroup_permissions" "test_object_name" {
+	group = nios_security_admin_group.test.name
+	permissions = [
+		{
+			object_type = "view"
+			object_name = nios_dns_view.test.name
+			permission  = "READ"
+		},
+	]
+}
+`
+	return testAccAdmingroupPermissionsParentConfig(group, view) + config
+}
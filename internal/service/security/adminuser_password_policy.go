@@ -0,0 +1,100 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+// gridPasswordPolicies caches the password policy of the Grid per client, so that it is read once per Terraform
+// operation instead of once for every Admin User.
+var gridPasswordPolicies sync.Map
+
+// adminuserPasswordPolicy is the password policy that applies to an Admin User. The complexity
+// requirements come from the Grid, the expiry setting of the Admin Group overrides the one of the Grid.
+type adminuserPasswordPolicy struct {
+	Complexity   customvalidator.PasswordPolicy
+	ExpireEnable bool
+	ExpireDays   int64
+}
+
+// readGridPasswordPolicy reads the password policy of the Grid.
+func readGridPasswordPolicy(ctx context.Context, client *niosclient.APIClient) (adminuserPasswordPolicy, error) {
+	if policy, ok := gridPasswordPolicies.Load(client); ok {
+		return policy.(adminuserPasswordPolicy), nil
+	}
+
+	gridRes, _, err := client.GridAPI.
+		GridAPI.
+		List(ctx).
+		ReturnFieldsPlus("password_setting").
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		return adminuserPasswordPolicy{}, err
+	}
+	grids := gridRes.ListGridResponseObject.GetResult()
+	if len(grids) == 0 {
+		return adminuserPasswordPolicy{}, errors.New("no Grid object found")
+	}
+	setting := grids[0].GetPasswordSetting()
+
+	policy := adminuserPasswordPolicy{
+		Complexity: customvalidator.PasswordPolicy{
+			MinLength:  setting.GetPasswordMinLength(),
+			NumLower:   setting.GetNumLowerChar(),
+			NumUpper:   setting.GetNumUpperChar(),
+			NumNumeric: setting.GetNumNumericChar(),
+			NumSymbol:  setting.GetNumSymbolChar(),
+		},
+		ExpireEnable: setting.GetExpireEnable(),
+		ExpireDays:   setting.GetExpireDays(),
+	}
+	gridPasswordPolicies.Store(client, policy)
+
+	return policy, nil
+}
+
+// readAdminuserPasswordPolicy reads the password policy for an Admin User of the given Admin Group.
+func readAdminuserPasswordPolicy(ctx context.Context, client *niosclient.APIClient, adminGroup string) (*adminuserPasswordPolicy, error) {
+	policy, err := readGridPasswordPolicy(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if adminGroup == "" {
+		return &policy, nil
+	}
+
+	groupRes, _, err := client.SecurityAPI.
+		AdmingroupAPI.
+		List(ctx).
+		Filters(map[string]any{"name": adminGroup}).
+		ReturnFieldsPlus("password_setting,use_password_setting").
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	groups := groupRes.ListAdmingroupResponseObject.GetResult()
+	if len(groups) > 0 && groups[0].GetUsePasswordSetting() {
+		groupSetting := groups[0].GetPasswordSetting()
+		policy.ExpireEnable = groupSetting.GetExpireEnable()
+		policy.ExpireDays = groupSetting.GetExpireDays()
+	}
+
+	return &policy, nil
+}
+
+// ExpiryTime returns the time a password set at lastChanged expires, or false if passwords do not expire.
+func (p *adminuserPasswordPolicy) ExpiryTime(lastChanged int64) (int64, bool) {
+	if !p.ExpireEnable || p.ExpireDays <= 0 {
+		return 0, false
+	}
+	return lastChanged + p.ExpireDays*int64(24*time.Hour/time.Second), true
+}
@@ -0,0 +1,28 @@
+package security
+
+import "testing"
+
+func TestAdminuserPasswordPolicyExpiryTime(t *testing.T) {
+	const lastChanged = int64(1760000000)
+
+	tests := []struct {
+		name       string
+		policy     adminuserPasswordPolicy
+		wantExpiry int64
+		wantOk     bool
+	}{
+		{"expiry disabled", adminuserPasswordPolicy{ExpireEnable: false, ExpireDays: 30}, 0, false},
+		{"no expiry days", adminuserPasswordPolicy{ExpireEnable: true, ExpireDays: 0}, 0, false},
+		{"one day", adminuserPasswordPolicy{ExpireEnable: true, ExpireDays: 1}, lastChanged + 86400, true},
+		{"thirty days", adminuserPasswordPolicy{ExpireEnable: true, ExpireDays: 30}, lastChanged + 30*86400, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiry, ok := tt.policy.ExpiryTime(lastChanged)
+			if expiry != tt.wantExpiry || ok != tt.wantOk {
+				t.Errorf("ExpiryTime(%d) = %d, %t, expected %d, %t", lastChanged, expiry, ok, tt.wantExpiry, tt.wantOk)
+			}
+		})
+	}
+}
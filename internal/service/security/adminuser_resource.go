@@ -7,12 +7,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/security"
@@ -20,6 +23,7 @@ import (
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
 )
 
 var readableAttributesForAdminuser = "admin_groups,auth_method,auth_type,ca_certificate_issuer,client_certificate_serial_number,comment,disable,email,enable_certificate_authentication,extattrs,name,ssh_keys,status,time_zone,use_ssh_keys,use_time_zone"
@@ -99,6 +103,12 @@ func (r *AdminuserResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 	}
 
 	computeNewHash := !planPassword.IsNull() && !planPassword.IsUnknown()
+	passwordChanged, passwordRotated := false, false
+
+	if planPassword.IsUnknown() {
+		// The password may change once it is known
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_last_changed"), types.Int64Unknown())...)
+	}
 
 	prevHashes := secretsHashState{}
 	plannedHashes := secretsHashState{}
@@ -151,11 +161,126 @@ func (r *AdminuserResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 				return
 			}
 			resp.Diagnostics.Append(resp.Private.SetKey(ctx, "password_hash", b)...)
+			passwordChanged = true
+		} else if r.passwordRotationDue(ctx, req, resp) {
+			// Set the unchanged password again to restart the expiry period
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_revision"), types.Int64Value(curRev+1))...)
+			passwordRotated = true
 		} else {
 			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_revision"), curRev)...)
 		}
 	}
 
+	if (!passwordChanged && !passwordRotated) || resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_last_changed"), types.Int64Unknown())...)
+
+	// A rotated password is unchanged and was validated when it was set
+	if passwordChanged && r.client != nil {
+		r.validatePassword(ctx, planPassword.ValueString(), &resp.Diagnostics)
+	}
+}
+
+// passwordRotationDue returns true if password_rotation_days is set and the password expires within that many days.
+func (r *AdminuserResource) passwordRotationDue(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) bool {
+	if req.State.Raw.IsNull() {
+		return false
+	}
+
+	var rotationDays, expiryTime, lastChanged types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("password_rotation_days"), &rotationDays)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password_expiry_time"), &expiryTime)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password_last_changed"), &lastChanged)...)
+	if resp.Diagnostics.HasError() || rotationDays.IsNull() || rotationDays.IsUnknown() || expiryTime.IsNull() || lastChanged.IsNull() {
+		return false
+	}
+
+	// A rotation period that is not shorter than the expiry period would set the password again on every plan
+	expireDays := (expiryTime.ValueInt64() - lastChanged.ValueInt64()) / int64(24*time.Hour/time.Second)
+	if rotationDays.ValueInt64() >= expireDays {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password_rotation_days"),
+			"Invalid Password Rotation Days",
+			fmt.Sprintf("password_rotation_days must be less than the password expiry period of %d days, otherwise the password is set again on every plan.", expireDays),
+		)
+		return false
+	}
+
+	rotateAt := time.Unix(expiryTime.ValueInt64(), 0).AddDate(0, 0, -int(rotationDays.ValueInt64()))
+	if time.Now().Before(rotateAt) {
+		return false
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("password"),
+		"Admin User Password Rotation",
+		fmt.Sprintf("The password expires on %s, which is within %d days. The password is set again to restart the expiry period.",
+			time.Unix(expiryTime.ValueInt64(), 0).UTC().Format(time.RFC3339), rotationDays.ValueInt64()),
+	)
+	return true
+}
+
+// validatePassword validates the password against the password complexity policy of the Grid. If the policy
+// cannot be read, the password is validated against the default policy instead.
+func (r *AdminuserResource) validatePassword(ctx context.Context, password string, diags *diag.Diagnostics) {
+	complexity := customvalidator.DefaultPasswordPolicy
+
+	policy, err := readGridPasswordPolicy(ctx, r.client)
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("password"),
+			"Unable to Read Password Policy",
+			fmt.Sprintf("Unable to read the password policy of the Grid, the password is validated against the default policy instead. Got error: %s", err),
+		)
+	} else {
+		complexity = policy.Complexity
+	}
+
+	if violations := customvalidator.CheckPasswordPolicy(password, complexity); len(violations) > 0 {
+		diags.AddAttributeError(
+			path.Root("password"),
+			"Invalid Password",
+			fmt.Sprintf("The password does not meet the password policy of the Grid. The password must contain %s.", strings.Join(violations, ", ")),
+		)
+	}
+}
+
+// flattenPasswordExpiry sets password_expiry_time from the time the password was last set by Terraform and the
+// password policy. If the policy cannot be read, the last known expiry time is kept.
+func (r *AdminuserResource) flattenPasswordExpiry(ctx context.Context, data *AdminuserModel, diags *diag.Diagnostics) {
+	if data.PasswordLastChanged.IsNull() || data.PasswordLastChanged.IsUnknown() {
+		data.PasswordExpiryTime = types.Int64Null()
+		return
+	}
+
+	var adminGroups []string
+	diags.Append(data.AdminGroups.ElementsAs(ctx, &adminGroups, true)...)
+	if diags.HasError() {
+		return
+	}
+
+	policy, err := readAdminuserPasswordPolicy(ctx, r.client, firstAdminGroup(adminGroups))
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read the password policy to determine when the password expires", map[string]any{"error": err.Error()})
+		if data.PasswordExpiryTime.IsUnknown() {
+			data.PasswordExpiryTime = types.Int64Null()
+		}
+		return
+	}
+	data.PasswordExpiryTime = types.Int64Null()
+	if expiry, ok := policy.ExpiryTime(data.PasswordLastChanged.ValueInt64()); ok {
+		data.PasswordExpiryTime = types.Int64Value(expiry)
+	}
+}
+
+// firstAdminGroup returns the Admin Group of an Admin User, which can only belong to one Admin Group.
+func firstAdminGroup(adminGroups []string) string {
+	if len(adminGroups) == 0 {
+		return ""
+	}
+	return adminGroups[0]
 }
 
 func (r *AdminuserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -204,6 +329,9 @@ func (r *AdminuserResource) Create(ctx context.Context, req resource.CreateReque
 			return
 		}
 		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "password_hash", hashedPassword)...)
+		data.PasswordLastChanged = types.Int64Value(time.Now().Unix())
+	} else {
+		data.PasswordLastChanged = types.Int64Null()
 	}
 
 	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
@@ -253,6 +381,7 @@ func (r *AdminuserResource) Create(ctx context.Context, req resource.CreateReque
 	if plannedSshKeys.IsNull() {
 		data.SshKeys = plannedSshKeys
 	}
+	r.flattenPasswordExpiry(ctx, &data, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -343,6 +472,7 @@ func (r *AdminuserResource) Read(ctx context.Context, req resource.ReadRequest,
 	if priorSshKeys.IsNull() {
 		data.SshKeys = priorSshKeys
 	}
+	r.flattenPasswordExpiry(ctx, &data, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -403,6 +533,7 @@ func (r *AdminuserResource) ReadByExtAttrs(ctx context.Context, data *AdminuserM
 	if priorSshKeys.IsNull() {
 		data.SshKeys = priorSshKeys
 	}
+	r.flattenPasswordExpiry(ctx, data, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 
 	return true
@@ -460,8 +591,10 @@ func (r *AdminuserResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if !password.IsNull() && !password.IsUnknown() {
+	// Only send the password if it changed, so the Grid does not reject it because of its password history
+	if data.PasswordLastChanged.IsUnknown() && !password.IsNull() && !password.IsUnknown() {
 		payload.Password = password.ValueStringPointer()
+		data.PasswordLastChanged = types.Int64Value(time.Now().Unix())
 	}
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
@@ -507,6 +640,10 @@ func (r *AdminuserResource) Update(ctx context.Context, req resource.UpdateReque
 	if plannedSshKeys.IsNull() {
 		data.SshKeys = plannedSshKeys
 	}
+	if data.PasswordLastChanged.IsUnknown() {
+		data.PasswordLastChanged = types.Int64Null()
+	}
+	r.flattenPasswordExpiry(ctx, &data, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -41,6 +42,8 @@ func TestAccAdminuserResource_basic(t *testing.T) {
 					testAccCheckAdminuserExists(context.Background(), resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "password_revision", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "password_last_changed"),
+					resource.TestCheckResourceAttr(resourceName, "locked", "false"),
 					// Test fields with default value
 					resource.TestCheckResourceAttr(resourceName, "auth_method", "KEYPAIR"),
 					resource.TestCheckResourceAttr(resourceName, "auth_type", "LOCAL"),
@@ -482,6 +485,67 @@ func TestAccAdminuserResource_Password(t *testing.T) {
 	})
 }
 
+func TestAccAdminuserResource_PasswordPolicy(t *testing.T) {
+	name := acctest.RandomNameWithPrefix("admin-user")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A password shorter than the minimum length of the Grid password policy is rejected during plan
+			{
+				Config:      testAccAdminuserPassword(name, "a1", "admin-group"),
+				ExpectError: regexp.MustCompile("The password does not meet the password policy of the Grid"),
+			},
+			// The password is also validated when the Admin Group is created in the same apply
+			{
+				Config:      testAccAdminuserPasswordRotationDays(name, "a1", acctest.RandomNameWithPrefix("admin-group"), 5),
+				ExpectError: regexp.MustCompile("The password does not meet the password policy of the Grid"),
+			},
+		},
+	})
+}
+
+func TestAccAdminuserResource_PasswordRotationDays(t *testing.T) {
+	var resourceName = "nios_security_admin_user.test_password_rotation_days"
+	var v security.Adminuser
+	name := acctest.RandomNameWithPrefix("admin-user")
+	adminGroup := acctest.RandomNameWithPrefix("admin-group")
+	password := "Example-Admin123!"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdminuserPasswordRotationDays(name, password, adminGroup, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdminuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "password_rotation_days", "5"),
+					resource.TestCheckResourceAttr(resourceName, "password_revision", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "password_expiry_time"),
+				),
+			},
+			// A rotation period that is not shorter than the expiry period of 30 days is rejected
+			{
+				Config:      testAccAdminuserPasswordRotationDays(name, password, adminGroup, 30),
+				ExpectError: regexp.MustCompile("Invalid Password Rotation Days"),
+			},
+			// Update and Read
+			{
+				Config: testAccAdminuserPasswordRotationDays(name, password, adminGroup, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdminuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "password_rotation_days", "10"),
+					resource.TestCheckResourceAttr(resourceName, "password_revision", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccAdminuserResource_SshKeys(t *testing.T) {
 	var resourceName = "nios_security_admin_user.test_ssh_keys"
 	var v security.Adminuser
@@ -864,6 +928,26 @@ resource "nios_security_admin_user" "test_password" {
 `, name, password, adminGroups)
 }
 
+func testAccAdminuserPasswordRotationDays(name, password, adminGroup string, passwordRotationDays int) string {
+	return fmt.Sprintf(`
+resource "nios_security_admin_group" "test_password_rotation_days" {
+   name = %q
+   use_password_setting = true
+   password_setting = {
+      expire_enable = true
+      expire_days   = 30
+   }
+}
+
+resource "nios_security_admin_user" "test_password_rotation_days" {
+   name = %q
+   password = %q
+   admin_groups = [nios_security_admin_group.test_password_rotation_days.name]
+   password_rotation_days = %d
+}
+`, adminGroup, name, password, passwordRotationDays)
+}
+
 func testAccAdminuserSshKeys(name, password, adminGroups, keyName, keyType, keyValue string) string {
 	return fmt.Sprintf(`
 resource "nios_security_admin_user" "test_ssh_keys" {
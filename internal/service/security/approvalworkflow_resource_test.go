@@ -0,0 +1,392 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForApprovalworkflow = "approval_group,approval_notify_to,approved_notify_to,approver_comment,enable_approval_notify,enable_approved_notify,enable_failed_notify,enable_notify_group,enable_notify_user,enable_rejected_notify,enable_rescheduled_notify,enable_succeeded_notify,extattrs,failed_notify_to,rejected_notify_to,rescheduled_notify_to,submitter_comment,submitter_group,succeeded_notify_to,ticket_number"
+
+func TestAccApprovalworkflowResource_basic(t *testing.T) {
+	var resourceName = "nios_security_approval_workflow.test"
+	var v security.Approvalworkflow
+	submitterGroup := acctest.RandomNameWithPrefix("submitter-group")
+	approvalGroup := acctest.RandomNameWithPrefix("approval-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccApprovalworkflowBasicConfig(submitterGroup, approvalGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "submitter_group", submitterGroup),
+					resource.TestCheckResourceAttr(resourceName, "approval_group", approvalGroup),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "approver_comment", "OPTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "submitter_comment", "OPTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "ticket_number", "OPTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "enable_approval_notify", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_approved_notify", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_failed_notify", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_notify_group", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_notify_user", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_rejected_notify", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_rescheduled_notify", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_succeeded_notify", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApprovalworkflowResource_disappears(t *testing.T) {
+	resourceName := "nios_security_approval_workflow.test"
+	var v security.Approvalworkflow
+	submitterGroup := acctest.RandomNameWithPrefix("submitter-group")
+	approvalGroup := acctest.RandomNameWithPrefix("approval-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckApprovalworkflowDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApprovalworkflowBasicConfig(submitterGroup, approvalGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					testAccCheckApprovalworkflowDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApprovalworkflowResource_ApprovalGroup(t *testing.T) {
+	var resourceName = "nios_security_approval_workflow.test_approval_group"
+	var v security.Approvalworkflow
+	submitterGroup := acctest.RandomNameWithPrefix("submitter-group")
+	approvalGroup1 := acctest.RandomNameWithPrefix("approval-group")
+	approvalGroup2 := acctest.RandomNameWithPrefix("approval-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccApprovalworkflowApprovalGroup(submitterGroup, approvalGroup1, approvalGroup2, "approval1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "approval_group", approvalGroup1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccApprovalworkflowApprovalGroup(submitterGroup, approvalGroup1, approvalGroup2, "approval2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "approval_group", approvalGroup2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApprovalworkflowResource_TicketNumber(t *testing.T) {
+	var resourceName = "nios_security_approval_workflow.test_ticket_number"
+	var v security.Approvalworkflow
+	submitterGroup := acctest.RandomNameWithPrefix("submitter-group")
+	approvalGroup := acctest.RandomNameWithPrefix("approval-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccApprovalworkflowTicketNumber(submitterGroup, approvalGroup, "REQUIRED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ticket_number", "REQUIRED"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccApprovalworkflowTicketNumber(submitterGroup, approvalGroup, "NOTUSED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ticket_number", "NOTUSED"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApprovalworkflowResource_SubmitterComment(t *testing.T) {
+	var resourceName = "nios_security_approval_workflow.test_submitter_comment"
+	var v security.Approvalworkflow
+	submitterGroup := acctest.RandomNameWithPrefix("submitter-group")
+	approvalGroup := acctest.RandomNameWithPrefix("approval-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccApprovalworkflowSubmitterComment(submitterGroup, approvalGroup, "REQUIRED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "submitter_comment", "REQUIRED"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccApprovalworkflowSubmitterComment(submitterGroup, approvalGroup, "NOTUSED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "submitter_comment", "NOTUSED"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApprovalworkflowResource_EnableNotify(t *testing.T) {
+	var resourceName = "nios_security_approval_workflow.test_enable_notify"
+	var v security.Approvalworkflow
+	submitterGroup := acctest.RandomNameWithPrefix("submitter-group")
+	approvalGroup := acctest.RandomNameWithPrefix("approval-group")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccApprovalworkflowEnableNotify(submitterGroup, approvalGroup, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_approval_notify", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enable_failed_notify", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enable_notify_group", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccApprovalworkflowEnableNotify(submitterGroup, approvalGroup, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_approval_notify", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_failed_notify", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_notify_group", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApprovalworkflowResource_ExtAttrs(t *testing.T) {
+	var resourceName = "nios_security_approval_workflow.test_extattrs"
+	var v security.Approvalworkflow
+	submitterGroup := acctest.RandomNameWithPrefix("submitter-group")
+	approvalGroup := acctest.RandomNameWithPrefix("approval-group")
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccApprovalworkflowExtAttrs(submitterGroup, approvalGroup, map[string]string{"Site": extAttrValue1}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccApprovalworkflowExtAttrs(submitterGroup, approvalGroup, map[string]string{"Site": extAttrValue2}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckApprovalworkflowExists(ctx context.Context, resourceName string, v *security.Approvalworkflow) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			ApprovalworkflowAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForApprovalworkflow).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetApprovalworkflowResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetApprovalworkflowResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckApprovalworkflowDestroy(ctx context.Context, v *security.Approvalworkflow) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.SecurityAPI.
+			ApprovalworkflowAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForApprovalworkflow).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckApprovalworkflowDisappears(ctx context.Context, v *security.Approvalworkflow) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.SecurityAPI.
+			ApprovalworkflowAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccApprovalworkflowAdminGroups(submitterGroup, approvalGroup string) string {
+	return fmt.Sprintf(`
+resource "nios_security_admin_group" "submitter" {
+    name = %q
+}
+
+resource "nios_security_admin_group" "approval" {
+    name = %q
+}
+`, submitterGroup, approvalGroup)
+}
+
+func testAccApprovalworkflowBasicConfig(submitterGroup, approvalGroup string) string {
+	config := `
+resource "nios_security_approval_workflow" "test" {
+    submitter_group = nios_security_admin_group.submitter.name
+    approval_group = nios_security_admin_group.approval.name
+}
+`
+	return testAccApprovalworkflowAdminGroups(submitterGroup, approvalGroup) + config
+}
+
+func testAccApprovalworkflowApprovalGroup(submitterGroup, approvalGroup1, approvalGroup2, approvalGroupResource string) string {
+	config := fmt.Sprintf(`
+resource "nios_security_admin_group" "submitter" {
+    name = %q
+}
+
+resource "nios_security_admin_group" "approval1" {
+    name = %q
+}
+
+resource "nios_security_admin_group" "approval2" {
+    name = %q
+}
+
+resource "nios_security_approval_workflow" "test_approval_group" {
+    submitter_group = nios_security_admin_group.submitter.name
+    approval_group = nios_security_admin_group.%s.name
+}
+`, submitterGroup, approvalGroup1, approvalGroup2, approvalGroupResource)
+	return config
+}
+
+func testAccApprovalworkflowTicketNumber(submitterGroup, approvalGroup, ticketNumber string) string {
+	config := fmt.Sprintf(`
+resource "nios_security_approval_workflow" "test_ticket_number" {
+    submitter_group = nios_security_admin_group.submitter.name
+    approval_group = nios_security_admin_group.approval.name
+    ticket_number = %q
+}
+`, ticketNumber)
+	return testAccApprovalworkflowAdminGroups(submitterGroup, approvalGroup) + config
+}
+
+func testAccApprovalworkflowSubmitterComment(submitterGroup, approvalGroup, submitterComment string) string {
+	config := fmt.Sprintf(`
+resource "nios_security_approval_workflow" "test_submitter_comment" {
+    submitter_group = nios_security_admin_group.submitter.name
+    approval_group = nios_security_admin_group.approval.name
+    submitter_comment = %q
+}
+`, submitterComment)
+	return testAccApprovalworkflowAdminGroups(submitterGroup, approvalGroup) + config
+}
+
+func testAccApprovalworkflowEnableNotify(submitterGroup, approvalGroup string, enableNotify bool) string {
+	config := fmt.Sprintf(`
+resource "nios_security_approval_workflow" "test_enable_notify" {
+    submitter_group = nios_security_admin_group.submitter.name
+    approval_group = nios_security_admin_group.approval.name
+    enable_approval_notify = %[1]t
+    enable_failed_notify = %[1]t
+    enable_notify_group = %[1]t
+}
+`, enableNotify)
+	return testAccApprovalworkflowAdminGroups(submitterGroup, approvalGroup) + config
+}
+
+func testAccApprovalworkflowExtAttrs(submitterGroup, approvalGroup string, extAttrs map[string]string) string {
+	extattrsStr := "{"
+	for k, v := range extAttrs {
+		extattrsStr += fmt.Sprintf(`%s = %q`, k, v)
+	}
+	extattrsStr += "}"
+	config := fmt.Sprintf(`
+resource "nios_security_approval_workflow" "test_extattrs" {
+    submitter_group = nios_security_admin_group.submitter.name
+    approval_group = nios_security_admin_group.approval.name
+    extattrs = %s
+}
+`, extattrsStr)
+	return testAccApprovalworkflowAdminGroups(submitterGroup, approvalGroup) + config
+}
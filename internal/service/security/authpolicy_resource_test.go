@@ -0,0 +1,212 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForAuthpolicy = "admin_groups,auth_services,default_group,usage_type"
+
+func TestAccAuthpolicyResource_basic(t *testing.T) {
+	var resourceName = "nios_security_authpolicy.test"
+	var v security.Authpolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAuthpolicyBasicConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auth_services.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_services.0", "data.nios_security_localuser_authservice.local", "result.0.ref"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "usage_type", "FULL"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAuthpolicyResource_AuthServices(t *testing.T) {
+	var resourceName = "nios_security_authpolicy.test_auth_services"
+	var v security.Authpolicy
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAuthpolicyAuthServices(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auth_services.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_services.0", "data.nios_security_localuser_authservice.local", "result.0.ref"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_services.1", "nios_security_ad_auth_service.test", "ref"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAuthpolicyAuthServices(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auth_services.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_services.0", "nios_security_ad_auth_service.test", "ref"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_services.1", "data.nios_security_localuser_authservice.local", "result.0.ref"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAuthpolicyResource_AdminGroups(t *testing.T) {
+	var resourceName = "nios_security_authpolicy.test_admin_groups"
+	var v security.Authpolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAuthpolicyAdminGroups([]string{"admin-group"}, "admin-group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "admin_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin_groups.0", "admin-group"),
+					resource.TestCheckResourceAttr(resourceName, "default_group", "admin-group"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAuthpolicyAdminGroups([]string{}, "admin-group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "admin_groups.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAuthpolicyResource_UsageType(t *testing.T) {
+	var resourceName = "nios_security_authpolicy.test_usage_type"
+	var v security.Authpolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAuthpolicyUsageType("AUTH_ONLY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "usage_type", "AUTH_ONLY"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAuthpolicyUsageType("FULL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "usage_type", "FULL"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckAuthpolicyExists(ctx context.Context, resourceName string, v *security.Authpolicy) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			AuthpolicyAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForAuthpolicy).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetAuthpolicyResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetAuthpolicyResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+const testAccAuthpolicyLocaluserAuthservice = `
+data "nios_security_localuser_authservice" "local" {}
+`
+
+func testAccAuthpolicyBasicConfig() string {
+	return testAccAuthpolicyLocaluserAuthservice + `
+resource "nios_security_authpolicy" "test" {
+    auth_services = [data.nios_security_localuser_authservice.local.result[0].ref]
+}
+`
+}
+
+func testAccAuthpolicyAuthServices(adName string, adFirst bool) string {
+	authServices := "[data.nios_security_localuser_authservice.local.result[0].ref, nios_security_ad_auth_service.test.ref]"
+	if adFirst {
+		authServices = "[nios_security_ad_auth_service.test.ref, data.nios_security_localuser_authservice.local.result[0].ref]"
+	}
+	return testAccAuthpolicyLocaluserAuthservice + fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test" {
+    name = %q
+    ad_domain = "corp.example.com"
+    domain_controllers = [
+        {
+            fqdn_or_ip = "10.0.0.10"
+        }
+    ]
+}
+
+resource "nios_security_authpolicy" "test_auth_services" {
+    auth_services = %s
+}
+`, adName, authServices)
+}
+
+func testAccAuthpolicyAdminGroups(adminGroups []string, defaultGroup string) string {
+	adminGroupsStr := utils.ConvertStringSliceToHCL(adminGroups)
+	return testAccAuthpolicyLocaluserAuthservice + fmt.Sprintf(`
+resource "nios_security_authpolicy" "test_admin_groups" {
+    auth_services = [data.nios_security_localuser_authservice.local.result[0].ref]
+    admin_groups = %s
+    default_group = %q
+}
+`, adminGroupsStr, defaultGroup)
+}
+
+func testAccAuthpolicyUsageType(usageType string) string {
+	return testAccAuthpolicyLocaluserAuthservice + fmt.Sprintf(`
+resource "nios_security_authpolicy" "test_usage_type" {
+    auth_services = [data.nios_security_localuser_authservice.local.result[0].ref]
+    usage_type = %q
+}
+`, usageType)
+}
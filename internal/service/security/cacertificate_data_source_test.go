@@ -0,0 +1,74 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccCacertificateDataSource_basic(t *testing.T) {
+	dataSourceName := "data.nios_security_cacertificate.test"
+	resourceName := "nios_security_cacertificate.test"
+	var v security.Cacertificate
+	certificateFilePath := filepath.Join(getCacertificateTestDataPath(), "ca.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCacertificateDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCacertificateDataSourceConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacertificateExists(context.Background(), resourceName, &v),
+					testAccCheckCacertificateInDataSource(dataSourceName, &v),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+// testAccCheckCacertificateInDataSource verifies that the data source returns the certificate with its expiry date.
+func testAccCheckCacertificateInDataSource(dataSourceName string, v *security.Cacertificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", dataSourceName)
+		}
+		for i := 0; ; i++ {
+			ref, ok := ds.Primary.Attributes[fmt.Sprintf("result.%d.ref", i)]
+			if !ok {
+				return fmt.Errorf("CA Certificate %s not returned by %s", v.GetRef(), dataSourceName)
+			}
+			if ref != v.GetRef() {
+				continue
+			}
+			validNotAfter := ds.Primary.Attributes[fmt.Sprintf("result.%d.valid_not_after", i)]
+			if validNotAfter != fmt.Sprint(v.GetValidNotAfter()) {
+				return fmt.Errorf("expected valid_not_after %d, got %s", v.GetValidNotAfter(), validNotAfter)
+			}
+			return nil
+		}
+	}
+}
+
+func testAccCacertificateDataSourceConfig(certificateFilePath string) string {
+	return fmt.Sprintf(`
+resource "nios_security_cacertificate" "test" {
+    certificate_file_path = %q
+}
+
+data "nios_security_cacertificate" "test" {
+  depends_on = [nios_security_cacertificate.test]
+}
+`, certificateFilePath)
+}
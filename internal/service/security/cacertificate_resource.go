@@ -0,0 +1,287 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForCacertificate = "distinguished_name,issuer,serial,used_by,valid_not_after,valid_not_before"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CacertificateResource{}
+var _ resource.ResourceWithImportState = &CacertificateResource{}
+var _ resource.ResourceWithModifyPlan = &CacertificateResource{}
+
+func NewCacertificateResource() resource.Resource {
+	return &CacertificateResource{}
+}
+
+// CacertificateResource defines the resource implementation.
+type CacertificateResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *CacertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "security_cacertificate"
+}
+
+func (r *CacertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a trusted CA Certificate uploaded from PEM content or a local file.",
+		Attributes:          CacertificateResourceSchemaAttributes,
+	}
+}
+
+func (r *CacertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan replaces the certificate when the configured file holds a different certificate than the uploaded one.
+func (r *CacertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state CacertificateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := readCertificatePEM(plan.Certificate, plan.CertificateFilePath)
+	if errors.Is(err, errCertificateNotAvailable) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate_file_path"), "Invalid Certificate", err.Error())
+		return
+	}
+	cert, err := parseCertificatePEM(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate"), "Invalid Certificate", err.Error())
+		return
+	}
+
+	if isSameCertificateSerial(state.Serial.ValueString(), certificateSerial(cert)) {
+		return
+	}
+
+	for _, attr := range []string{"ref", "distinguished_name", "issuer", "serial", "used_by"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), types.StringUnknown())...)
+	}
+	for _, attr := range []string{"valid_not_after", "valid_not_before"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), types.Int64Unknown())...)
+	}
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("serial"))
+}
+
+func (r *CacertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CacertificateModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pemData, err := readCertificatePEM(data.Certificate, data.CertificateFilePath)
+	if errors.Is(err, errCertificateNotAvailable) {
+		err = fmt.Errorf("certificate file %s does not exist", data.CertificateFilePath.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Certificate", err.Error())
+		return
+	}
+	cert, err := parseCertificatePEM(pemData)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Certificate", err.Error())
+		return
+	}
+	serial := certificateSerial(cert)
+
+	existing, err := r.findBySerial(ctx, serial)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Cacertificate, got error: %s", err))
+		return
+	}
+	if existing != nil {
+		resp.Diagnostics.AddError(
+			"Resource Already Exists",
+			fmt.Sprintf("A CA Certificate with serial %s already exists.\nPlease import the existing resource into terraform state.", serial),
+		)
+		return
+	}
+
+	if err := uploadCertificate(ctx, r.client, "EAP_CA", data.Member.ValueString(), pemData); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload Cacertificate, got error: %s", err))
+		return
+	}
+
+	res, err := r.findBySerial(ctx, serial)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Cacertificate, got error: %s", err))
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("The CA Certificate with serial %s was uploaded but is not trusted by the Grid", serial))
+		return
+	}
+
+	data.Flatten(ctx, res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CacertificateModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *security.GetCacertificateResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.SecurityAPI.
+			CacertificateAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForCacertificate).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Cacertificate, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetCacertificateResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CacertificateModel
+
+	// A CA Certificate cannot be changed in place, every change of the certificate replaces it
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CacertificateModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		httpRes, callErr := r.client.SecurityAPI.
+			CacertificateAPI.
+			Delete(ctx, resourceRef).
+			Execute()
+
+		if httpRes != nil {
+			if httpRes.StatusCode == http.StatusNotFound {
+				return 0, nil
+			}
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Cacertificate, got error: %s", err))
+		return
+	}
+}
+
+func (r *CacertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
+
+// findBySerial returns the trusted CA Certificate with the given serial number, or nil if there is none.
+func (r *CacertificateResource) findBySerial(ctx context.Context, serial string) (*security.Cacertificate, error) {
+	apiRes, _, err := r.client.SecurityAPI.
+		CacertificateAPI.
+		List(ctx).
+		ReturnFieldsPlus(readableAttributesForCacertificate).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cert := range apiRes.ListCacertificateResponseObject.GetResult() {
+		if isSameCertificateSerial(cert.GetSerial(), serial) {
+			return &cert, nil
+		}
+	}
+	return nil, nil
+}
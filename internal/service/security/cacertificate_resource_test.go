@@ -0,0 +1,278 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForCacertificate = "distinguished_name,issuer,serial,used_by,valid_not_after,valid_not_before"
+
+func TestAccCacertificateResource_basic(t *testing.T) {
+	var resourceName = "nios_security_cacertificate.test"
+	var v security.Cacertificate
+	certificateFilePath := filepath.Join(getCacertificateTestDataPath(), "ca.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCacertificateDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCacertificateBasicConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacertificateExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_file_path", certificateFilePath),
+					resource.TestCheckResourceAttrSet(resourceName, "distinguished_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "issuer"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial"),
+					resource.TestCheckResourceAttrSet(resourceName, "valid_not_after"),
+					resource.TestCheckResourceAttrSet(resourceName, "valid_not_before"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCacertificateResource_disappears(t *testing.T) {
+	resourceName := "nios_security_cacertificate.test"
+	var v security.Cacertificate
+	certificateFilePath := filepath.Join(getCacertificateTestDataPath(), "ca.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCacertificateDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCacertificateBasicConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacertificateExists(context.Background(), resourceName, &v),
+					testAccCheckCacertificateDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCacertificateResource_Certificate(t *testing.T) {
+	var resourceName = "nios_security_cacertificate.test_certificate"
+	var v security.Cacertificate
+	certificate, err := os.ReadFile(filepath.Join(getCacertificateTestDataPath(), "ca.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificateUpdated, err := os.ReadFile(filepath.Join(getCacertificateTestDataPath(), "ca_updated.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCacertificateCertificate(string(certificate)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacertificateExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate", string(certificate)),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccCacertificateCertificate(string(certificateUpdated)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacertificateExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate", string(certificateUpdated)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCacertificateResource_CertificateFilePath(t *testing.T) {
+	var resourceName = "nios_security_cacertificate.test"
+	var v security.Cacertificate
+	var serial string
+	certificateFilePath := filepath.Join(t.TempDir(), "ca.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				PreConfig: func() { copyCacertificateTestFile(t, "ca.pem", certificateFilePath) },
+				Config:    testAccCacertificateBasicConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacertificateExists(context.Background(), resourceName, &v),
+					func(s *terraform.State) error {
+						serial = v.GetSerial()
+						return nil
+					},
+				),
+			},
+			// Replace the certificate when the file holds a renewed certificate
+			{
+				PreConfig: func() { copyCacertificateTestFile(t, "ca_updated.pem", certificateFilePath) },
+				Config:    testAccCacertificateBasicConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacertificateExists(context.Background(), resourceName, &v),
+					func(s *terraform.State) error {
+						if v.GetSerial() == serial {
+							return fmt.Errorf("expected the certificate to be replaced, serial is still %s", serial)
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCacertificateResource_Import(t *testing.T) {
+	var resourceName = "nios_security_cacertificate.test"
+	var v security.Cacertificate
+	certificateFilePath := filepath.Join(getCacertificateTestDataPath(), "ca.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCacertificateBasicConfig(certificateFilePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacertificateExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccCacertificateImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"certificate_file_path"},
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func testAccCheckCacertificateExists(ctx context.Context, resourceName string, v *security.Cacertificate) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			CacertificateAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForCacertificate).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetCacertificateResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetCacertificateResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckCacertificateDestroy(ctx context.Context, v *security.Cacertificate) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.SecurityAPI.
+			CacertificateAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForCacertificate).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckCacertificateDisappears(ctx context.Context, v *security.Cacertificate) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.SecurityAPI.
+			CacertificateAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCacertificateImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCacertificateBasicConfig(certificateFilePath string) string {
+	return fmt.Sprintf(`
+resource "nios_security_cacertificate" "test" {
+    certificate_file_path = %q
+}
+`, certificateFilePath)
+}
+
+func testAccCacertificateCertificate(certificate string) string {
+	return fmt.Sprintf(`
+resource "nios_security_cacertificate" "test_certificate" {
+    certificate = %q
+}
+`, certificate)
+}
+
+func getCacertificateTestDataPath() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "../../testdata/nios_security_cacertificate"
+	}
+	return filepath.Join(wd, "../../testdata/nios_security_cacertificate")
+}
+
+func copyCacertificateTestFile(t *testing.T, name, dest string) {
+	data, err := os.ReadFile(filepath.Join(getCacertificateTestDataPath(), name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dest, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
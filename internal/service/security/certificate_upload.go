@@ -0,0 +1,130 @@
+package security
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// errCertificateNotAvailable is returned when the configured certificate cannot be read yet, for example because
+// the file is created during the same apply.
+var errCertificateNotAvailable = errors.New("certificate is not available")
+
+// readCertificatePEM returns the PEM encoded certificate configured either as content or as a local file path.
+func readCertificatePEM(content, filePath types.String) ([]byte, error) {
+	if content.IsUnknown() || filePath.IsUnknown() {
+		return nil, errCertificateNotAvailable
+	}
+	if !content.IsNull() {
+		return []byte(content.ValueString()), nil
+	}
+	if filePath.IsNull() {
+		return nil, errCertificateNotAvailable
+	}
+
+	data, err := os.ReadFile(filePath.ValueString())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, errCertificateNotAvailable
+		}
+		return nil, fmt.Errorf("unable to read certificate file %s: %w", filePath.ValueString(), err)
+	}
+	return data, nil
+}
+
+// parseCertificatePEM parses the first certificate of PEM encoded data, which may also hold the chain and a private key.
+func parseCertificatePEM(data []byte) (*x509.Certificate, error) {
+	rest := data
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("no PEM encoded certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse certificate: %w", err)
+			}
+			return cert, nil
+		}
+	}
+}
+
+// certificateSerial returns the serial number of the certificate in the hex format used by NIOS.
+func certificateSerial(cert *x509.Certificate) string {
+	return cert.SerialNumber.Text(16)
+}
+
+// isSameCertificateSerial compares serial numbers in hex format, ignoring case, separators and leading zeros.
+func isSameCertificateSerial(a, b string) bool {
+	normalize := func(s string) string {
+		s = strings.ToLower(strings.ReplaceAll(s, ":", ""))
+		s = strings.TrimLeft(s, "0")
+		return s
+	}
+	return normalize(a) == normalize(b)
+}
+
+// uploadCertificate uploads PEM encoded data with the fileop uploadcertificate function.
+func uploadCertificate(ctx context.Context, client *niosclient.APIClient, certificateUsage, member string, data []byte) error {
+	baseUrl := client.SecurityAPI.Cfg.NIOSHostURL
+	username := client.SecurityAPI.Cfg.NIOSUsername
+	password := client.SecurityAPI.Cfg.NIOSPassword
+
+	certificateFile, err := os.CreateTemp("", "nios-certificate-*.pem")
+	if err != nil {
+		return fmt.Errorf("unable to create certificate file: %w", err)
+	}
+	defer func() { _ = os.Remove(certificateFile.Name()) }()
+
+	_, err = certificateFile.Write(data)
+	if closeErr := certificateFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write certificate file: %w", err)
+	}
+
+	token, err := utils.UploadFileWithToken(ctx, baseUrl, certificateFile.Name(), username, password)
+	if err != nil {
+		return err
+	}
+
+	args := map[string]any{
+		"certificate_usage": certificateUsage,
+		"token":             token,
+	}
+	if member != "" {
+		args["member"] = member
+	}
+	_, err = utils.CallWAPIFunction(ctx, baseUrl, username, password, "fileop", "uploadcertificate", args)
+	return err
+}
+
+// downloadCertificate downloads the certificate a member uses for the given purpose with the fileop
+// downloadcertificate function.
+func downloadCertificate(ctx context.Context, client *niosclient.APIClient, certificateUsage, member string) ([]byte, error) {
+	return utils.DownloadFileWithFunction(
+		ctx,
+		client.SecurityAPI.Cfg.NIOSHostURL,
+		client.SecurityAPI.Cfg.NIOSUsername,
+		client.SecurityAPI.Cfg.NIOSPassword,
+		"fileop",
+		"downloadcertificate",
+		map[string]any{
+			"certificate_usage": certificateUsage,
+			"member":            member,
+		},
+	)
+}
@@ -0,0 +1,128 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CsrResource{}
+
+func NewCsrResource() resource.Resource {
+	return &CsrResource{}
+}
+
+// CsrResource defines the resource implementation.
+type CsrResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *CsrResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "security_csr"
+}
+
+func (r *CsrResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a new key pair and a Certificate Signing Request (CSR) on a member. Any change of the arguments, including `triggers`, replaces the resource, which generates a new key pair and CSR. The key pair is used by the certificate that is uploaded next for the same member and purpose. Destroying the resource only removes it from the Terraform state.",
+		Attributes:          CsrResourceSchemaAttributes,
+	}
+}
+
+func (r *CsrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CsrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CsrModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.generate(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CsrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A generated CSR cannot be read back from NIOS, the state is kept as is
+}
+
+func (r *CsrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CsrModel
+
+	// Read Terraform plan and state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All arguments require replacement, a new key pair is only generated on create
+	data.Csr = state.Csr
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CsrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The key pair stays on the member until it is replaced, so just clear state
+	resp.State.RemoveResource(ctx)
+}
+
+// generate generates the key pair and CSR on the member with the fileop generatecsr function and downloads the CSR.
+func (r *CsrResource) generate(ctx context.Context, data *CsrModel, diags *diag.Diagnostics) {
+	args := data.Args(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	csr, err := utils.DownloadFileWithFunction(
+		ctx,
+		r.client.SecurityAPI.Cfg.NIOSHostURL,
+		r.client.SecurityAPI.Cfg.NIOSUsername,
+		r.client.SecurityAPI.Cfg.NIOSPassword,
+		"fileop",
+		"generatecsr",
+		args,
+	)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to generate CSR on member %s, got error: %s", data.Member.ValueString(), err))
+		return
+	}
+
+	data.Csr = types.StringValue(string(csr))
+}
@@ -0,0 +1,157 @@
+package security_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var csrRegexp = regexp.MustCompile(`-----BEGIN (NEW )?CERTIFICATE REQUEST-----`)
+
+func TestAccCsrResource_basic(t *testing.T) {
+	var resourceName = "nios_security_csr.test"
+	member := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCsrBasicConfig(member, member),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "member", member),
+					resource.TestCheckResourceAttr(resourceName, "cn", member),
+					resource.TestMatchResourceAttr(resourceName, "csr", csrRegexp),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "certificate_usage", "ADMIN"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA-256"),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "2048"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCsrResource_SubjectAlternativeNames(t *testing.T) {
+	var resourceName = "nios_security_csr.test_subject_alternative_names"
+	member := utils.GetNIOSGridMasterHostName()
+	subjectAlternativeNames := []map[string]any{
+		{
+			"type":  "DNS",
+			"value": member,
+		},
+	}
+	subjectAlternativeNamesUpdated := []map[string]any{
+		{
+			"type":  "DNS",
+			"value": member,
+		},
+		{
+			"type":  "DNS",
+			"value": "nios.example.com",
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCsrSubjectAlternativeNames(member, subjectAlternativeNames),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "csr", csrRegexp),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccCsrSubjectAlternativeNames(member, subjectAlternativeNamesUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.1.value", "nios.example.com"),
+					resource.TestMatchResourceAttr(resourceName, "csr", csrRegexp),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCsrResource_Triggers(t *testing.T) {
+	var resourceName = "nios_security_csr.test_triggers"
+	var csr string
+	member := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCsrTriggers(member, "2025-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2025-01-01"),
+					resource.TestCheckResourceAttrWith(resourceName, "csr", func(value string) error {
+						csr = value
+						return nil
+					}),
+				),
+			},
+			// Changing the triggers generates a new CSR
+			{
+				Config: testAccCsrTriggers(member, "2025-03-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2025-03-01"),
+					resource.TestMatchResourceAttr(resourceName, "csr", csrRegexp),
+					resource.TestCheckResourceAttrWith(resourceName, "csr", func(value string) error {
+						if value == csr {
+							return fmt.Errorf("expected a new CSR after changing the triggers")
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCsrBasicConfig(member, cn string) string {
+	return fmt.Sprintf(`
+resource "nios_security_csr" "test" {
+    member = %q
+    cn = %q
+}
+`, member, cn)
+}
+
+func testAccCsrSubjectAlternativeNames(member string, subjectAlternativeNames []map[string]any) string {
+	subjectAlternativeNamesStr := utils.ConvertSliceOfMapsToHCL(subjectAlternativeNames)
+	return fmt.Sprintf(`
+resource "nios_security_csr" "test_subject_alternative_names" {
+    member = %q
+    cn = %q
+    subject_alternative_names = %s
+}
+`, member, member, subjectAlternativeNamesStr)
+}
+
+func testAccCsrTriggers(member, rotation string) string {
+	return fmt.Sprintf(`
+resource "nios_security_csr" "test_triggers" {
+    member = %q
+    cn = %q
+    triggers = {
+        rotation = %q
+    }
+}
+`, member, member, rotation)
+}
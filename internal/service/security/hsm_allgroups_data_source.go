@@ -0,0 +1,167 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HsmAllgroupsDataSource{}
+
+func NewHsmAllgroupsDataSource() datasource.DataSource {
+	return &HsmAllgroupsDataSource{}
+}
+
+// HsmAllgroupsDataSource defines the data source implementation.
+type HsmAllgroupsDataSource struct {
+	client *niosclient.APIClient
+}
+
+func (d *HsmAllgroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "security_hsm_allgroups"
+}
+
+func (d *HsmAllgroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the status of all HSM groups configured on the Grid.",
+		Attributes: map[string]schema.Attribute{
+			"ref": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The reference to the object.",
+			},
+			"groups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ref": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The reference to the HSM group.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the HSM group, either `THALESLUNA` or `ENTRUSTNSHIELD`.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the HSM group.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of all HSM devices in the group.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The list of HSM groups configured on the appliance.",
+			},
+		},
+	}
+}
+
+func (d *HsmAllgroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HsmAllgroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HsmAllgroupsModel
+
+	apiRes, _, err := d.client.SecurityAPI.
+		HsmAllgroupsAPI.
+		List(ctx).
+		ReturnFieldsPlus("groups").
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HsmAllgroups, got error: %s", err))
+		return
+	}
+
+	res := apiRes.ListHsmAllgroupsResponseObject.GetResult()
+	if len(res) == 0 {
+		resp.Diagnostics.AddError("Client Error", "Unable to read HsmAllgroups, no object was returned")
+		return
+	}
+	data.Ref = types.StringValue(res[0].GetRef())
+
+	groups := make([]HsmAllgroupsGroupModel, 0, len(res[0].Groups))
+	for _, groupRef := range res[0].Groups {
+		group, err := d.readGroup(ctx, groupRef)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HSM group %s, got error: %s", groupRef, err))
+			return
+		}
+		groups = append(groups, group)
+	}
+
+	groupList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HsmAllgroupsGroupAttrTypes}, groups)
+	resp.Diagnostics.Append(diags...)
+	data.Groups = groupList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readGroup reads the name and status of the HSM group with the given reference.
+func (d *HsmAllgroupsDataSource) readGroup(ctx context.Context, groupRef string) (HsmAllgroupsGroupModel, error) {
+	group := HsmAllgroupsGroupModel{Ref: types.StringValue(groupRef)}
+	resourceRef := utils.ExtractResourceRef(groupRef)
+
+	switch {
+	case strings.HasPrefix(groupRef, "hsm:thaleslunagroup/"):
+		apiRes, _, err := d.client.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus("name,status").
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return group, err
+		}
+		res := apiRes.GetHsmThaleslunagroupResponseObjectAsResult.GetResult()
+		group.Type = types.StringValue("THALESLUNA")
+		group.Name = types.StringValue(res.GetName())
+		group.Status = types.StringValue(res.GetStatus())
+	case strings.HasPrefix(groupRef, "hsm:entrustnshieldgroup/"):
+		apiRes, _, err := d.client.SecurityAPI.
+			HsmEntrustnshieldgroupAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus("name,status").
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return group, err
+		}
+		res := apiRes.GetHsmEntrustnshieldgroupResponseObjectAsResult.GetResult()
+		group.Type = types.StringValue("ENTRUSTNSHIELD")
+		group.Name = types.StringValue(res.GetName())
+		group.Status = types.StringValue(res.GetStatus())
+	default:
+		return group, fmt.Errorf("unsupported HSM group type")
+	}
+
+	return group, nil
+}
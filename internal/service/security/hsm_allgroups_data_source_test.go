@@ -0,0 +1,58 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+func TestAccHsmAllgroupsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.nios_security_hsm_allgroups.test"
+	resourceName := "nios_security_hsm_thaleslunagroup.test"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := testAccHsmThaleslunagroupDevices("10.0.0.31", "1234567")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHsmThaleslunagroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHsmAllgroupsDataSourceConfig(name, thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ref"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "groups.*", map[string]string{
+						"name": name,
+						"type": "THALESLUNA",
+					}),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccHsmAllgroupsDataSourceConfig(name string, thalesluna []map[string]any) string {
+	thaleslunaStr := utils.ConvertSliceOfMapsToHCL(thalesluna)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_thaleslunagroup" "test" {
+	name = %q
+	hsm_version = "Luna_7_CPL"
+	pass_phrase = "partition-password"
+	thalesluna = %s
+}
+
+data "nios_security_hsm_allgroups" "test" {
+  depends_on = [nios_security_hsm_thaleslunagroup.test]
+}
+`, name, thaleslunaStr)
+}
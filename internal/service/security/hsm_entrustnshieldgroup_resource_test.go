@@ -0,0 +1,441 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// Entrust nShield HSM devices: 10.0.0.41 and 10.0.0.42, remote file server 10.0.0.40 with softcard tf-softcard, or a mocked WAPI
+
+var readableAttributesForHsmEntrustnshieldgroup = "card_name,comment,entrustnshield_hsm,key_server_ip,key_server_port,name,protection,status"
+
+func TestAccHsmEntrustnshieldgroupResource_basic(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := testAccHsmEntrustnshieldgroupDevices("10.0.0.41")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name, "10.0.0.40", "MODULE", entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "key_server_ip", "10.0.0.40"),
+					resource.TestCheckResourceAttr(resourceName, "protection", "MODULE"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.remote_ip", "10.0.0.41"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "key_server_port", "9004"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.remote_port", "9004"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_disappears(t *testing.T) {
+	resourceName := "nios_security_hsm_entrustnshieldgroup.test"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := testAccHsmEntrustnshieldgroupDevices("10.0.0.41")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHsmEntrustnshieldgroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name, "10.0.0.40", "MODULE", entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					testAccCheckHsmEntrustnshieldgroupDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_Import(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := testAccHsmEntrustnshieldgroupDevices("10.0.0.41")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name, "10.0.0.40", "MODULE", entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccHsmEntrustnshieldgroupImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_Comment(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test_comment"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := testAccHsmEntrustnshieldgroupDevices("10.0.0.41")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupComment(name, entrustnshieldHsm, "nShield group for DNSSEC keys"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "nShield group for DNSSEC keys"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupComment(name, entrustnshieldHsm, "Updated comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Updated comment"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_EntrustnshieldHsm(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test_entrustnshield_hsm"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := testAccHsmEntrustnshieldgroupDevices("10.0.0.41")
+	entrustnshieldHsmUpdated := []map[string]any{
+		{
+			"remote_ip":  "10.0.0.41",
+			"remote_esn": "ABCD-1234-EF56",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234567",
+			"disable":    true,
+		},
+		{
+			"remote_ip":   "10.0.0.42",
+			"remote_port": 9005,
+			"remote_esn":  "ABCD-5678-EF90",
+			"keyhash":     "fedcba9876543210fedcba9876543210fedcba98",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupEntrustnshieldHsm(name, entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.remote_esn", "ABCD-1234-EF56"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupEntrustnshieldHsm(name, entrustnshieldHsmUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.disable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.1.remote_ip", "10.0.0.42"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.1.remote_port", "9005"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_KeyServerPort(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test_key_server_port"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := testAccHsmEntrustnshieldgroupDevices("10.0.0.41")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupKeyServerPort(name, entrustnshieldHsm, 9004),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "key_server_port", "9004"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupKeyServerPort(name, entrustnshieldHsm, 9104),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "key_server_port", "9104"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_Protection(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test_protection"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := testAccHsmEntrustnshieldgroupDevices("10.0.0.41")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupProtection(name, entrustnshieldHsm, "MODULE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "protection", "MODULE"),
+				),
+			},
+			// Switch to softcard protection, which needs the softcard pass phrase
+			{
+				Config: testAccHsmEntrustnshieldgroupProtection(name, entrustnshieldHsm, "SOFTCARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "protection", "SOFTCARD"),
+					resource.TestCheckResourceAttr(resourceName, "card_name", "tf-softcard"),
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase_version", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_PassPhrase(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test_pass_phrase"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := testAccHsmEntrustnshieldgroupDevices("10.0.0.41")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupPassPhrase(name, entrustnshieldHsm, "softcard-pass-phrase"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase_version", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "pass_phrase"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupPassPhrase(name, entrustnshieldHsm, "rotated-softcard-pass-phrase"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase_version", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckHsmEntrustnshieldgroupExists(ctx context.Context, resourceName string, v *security.HsmEntrustnshieldgroup) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			HsmEntrustnshieldgroupAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForHsmEntrustnshieldgroup).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetHsmEntrustnshieldgroupResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetHsmEntrustnshieldgroupResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckHsmEntrustnshieldgroupDestroy(ctx context.Context, v *security.HsmEntrustnshieldgroup) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.SecurityAPI.
+			HsmEntrustnshieldgroupAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForHsmEntrustnshieldgroup).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckHsmEntrustnshieldgroupDisappears(ctx context.Context, v *security.HsmEntrustnshieldgroup) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.SecurityAPI.
+			HsmEntrustnshieldgroupAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccHsmEntrustnshieldgroupImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccHsmEntrustnshieldgroupDevices(remoteIp string) []map[string]any {
+	return []map[string]any{
+		{
+			"remote_ip":  remoteIp,
+			"remote_esn": "ABCD-1234-EF56",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234567",
+		},
+	}
+}
+
+func testAccHsmEntrustnshieldgroupBasicConfig(name, keyServerIp, protection string, entrustnshieldHsm []map[string]any) string {
+	entrustnshieldHsmStr := utils.ConvertSliceOfMapsToHCL(entrustnshieldHsm)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test" {
+	name = %q
+	key_server_ip = %q
+	protection = %q
+	entrustnshield_hsm = %s
+}
+`, name, keyServerIp, protection, entrustnshieldHsmStr)
+}
+
+func testAccHsmEntrustnshieldgroupComment(name string, entrustnshieldHsm []map[string]any, comment string) string {
+	entrustnshieldHsmStr := utils.ConvertSliceOfMapsToHCL(entrustnshieldHsm)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test_comment" {
+	name = %q
+	key_server_ip = "10.0.0.40"
+	protection = "MODULE"
+	entrustnshield_hsm = %s
+	comment = %q
+}
+`, name, entrustnshieldHsmStr, comment)
+}
+
+func testAccHsmEntrustnshieldgroupEntrustnshieldHsm(name string, entrustnshieldHsm []map[string]any) string {
+	entrustnshieldHsmStr := utils.ConvertSliceOfMapsToHCL(entrustnshieldHsm)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test_entrustnshield_hsm" {
+	name = %q
+	key_server_ip = "10.0.0.40"
+	protection = "MODULE"
+	entrustnshield_hsm = %s
+}
+`, name, entrustnshieldHsmStr)
+}
+
+func testAccHsmEntrustnshieldgroupKeyServerPort(name string, entrustnshieldHsm []map[string]any, keyServerPort int) string {
+	entrustnshieldHsmStr := utils.ConvertSliceOfMapsToHCL(entrustnshieldHsm)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test_key_server_port" {
+	name = %q
+	key_server_ip = "10.0.0.40"
+	key_server_port = %d
+	protection = "MODULE"
+	entrustnshield_hsm = %s
+}
+`, name, keyServerPort, entrustnshieldHsmStr)
+}
+
+func testAccHsmEntrustnshieldgroupPassPhrase(name string, entrustnshieldHsm []map[string]any, passPhrase string) string {
+	entrustnshieldHsmStr := utils.ConvertSliceOfMapsToHCL(entrustnshieldHsm)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test_pass_phrase" {
+	name = %q
+	key_server_ip = "10.0.0.40"
+	protection = "SOFTCARD"
+	card_name = "tf-softcard"
+	pass_phrase = %q
+	entrustnshield_hsm = %s
+}
+`, name, passPhrase, entrustnshieldHsmStr)
+}
+
+func testAccHsmEntrustnshieldgroupProtection(name string, entrustnshieldHsm []map[string]any, protection string) string {
+	entrustnshieldHsmStr := utils.ConvertSliceOfMapsToHCL(entrustnshieldHsm)
+	softcard := ""
+	if protection == "SOFTCARD" {
+		softcard = `
+	card_name = "tf-softcard"
+	pass_phrase = "softcard-pass-phrase"`
+	}
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test_protection" {
+	name = %q
+	key_server_ip = "10.0.0.40"
+	protection = %q%s
+	entrustnshield_hsm = %s
+}
+`, name, protection, softcard, entrustnshieldHsmStr)
+}
@@ -0,0 +1,112 @@
+package security
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type hsmPassPhraseHashState struct {
+	PassPhrase string `json:"pass_phrase_hash"`
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func hashHsmPassPhrase(passPhrase types.String) string {
+	h := sha256.New()
+	h.Write([]byte(passPhrase.ValueString()))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func marshalHsmPassPhraseHash(hash string) ([]byte, error) {
+	secretDataJSON, err := json.Marshal(hsmPassPhraseHashState{PassPhrase: hash})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]string{"algo": "sha256", "hash": string(secretDataJSON)})
+}
+
+// modifyHsmPassPhrasePlan increments pass_phrase_version when the configured pass phrase differs from the
+// one whose hash is kept in private state.
+func modifyHsmPassPhrasePlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateRev types.Int64
+	var planPassPhrase types.String
+
+	curRev := int64(0)
+	if !req.State.Raw.IsNull() && req.State.Raw.IsKnown() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("pass_phrase_version"), &stateRev)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !stateRev.IsNull() && !stateRev.IsUnknown() {
+			curRev = stateRev.ValueInt64()
+		}
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pass_phrase"), &planPassPhrase)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planPassPhrase.IsNull() || planPassPhrase.IsUnknown() {
+		return
+	}
+
+	var prev struct {
+		Algo string `json:"algo"`
+		Hash string `json:"hash"`
+	}
+	prevHashes := hsmPassPhraseHashState{}
+
+	if b, diags := req.Private.GetKey(ctx, "pass_phrase_hash"); diags != nil {
+		resp.Diagnostics.Append(diags...)
+	} else if b != nil {
+		if err := json.Unmarshal(b, &prev); err != nil {
+			prev.Hash = ""
+		}
+	}
+	if prev.Hash != "" {
+		_ = json.Unmarshal([]byte(prev.Hash), &prevHashes)
+	}
+
+	plannedHash := hashHsmPassPhrase(planPassPhrase)
+	if plannedHash == prevHashes.PassPhrase {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pass_phrase_version"), curRev)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pass_phrase_version"), types.Int64Value(curRev+1))...)
+	b, err := marshalHsmPassPhraseHash(plannedHash)
+	if err != nil {
+		resp.Diagnostics.AddError("Private State Marshal Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "pass_phrase_hash", b)...)
+}
+
+// storeHsmPassPhraseHash keeps the hash of a newly set pass phrase in private state and returns the initial
+// pass_phrase_version.
+func storeHsmPassPhraseHash(ctx context.Context, passPhrase types.String, private privateStateSetter, diags *diag.Diagnostics) types.Int64 {
+	if passPhrase.IsNull() || passPhrase.IsUnknown() {
+		return types.Int64Value(0)
+	}
+	b, err := marshalHsmPassPhraseHash(hashHsmPassPhrase(passPhrase))
+	if err != nil {
+		diags.AddError("Private State Marshal Error", err.Error())
+		return types.Int64Value(0)
+	}
+	diags.Append(private.SetKey(ctx, "pass_phrase_hash", b)...)
+	return types.Int64Value(1)
+}
@@ -0,0 +1,456 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// HSM Thales Luna devices: 10.0.0.31 and 10.0.0.32 with partition serial numbers 1234567 and 7654321, or a mocked WAPI
+
+var readableAttributesForHsmThaleslunagroup = "comment,group_sn,hsm_version,name,status,thalesluna"
+
+func TestAccHsmThaleslunagroupResource_basic(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := testAccHsmThaleslunagroupDevices("10.0.0.31", "1234567")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name, "Luna_7_CPL", "partition-password", thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "hsm_version", "Luna_7_CPL"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.name", "10.0.0.31"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.partition_serial_number", "1234567"),
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase_version", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "pass_phrase"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_disappears(t *testing.T) {
+	resourceName := "nios_security_hsm_thaleslunagroup.test"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := testAccHsmThaleslunagroupDevices("10.0.0.31", "1234567")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHsmThaleslunagroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name, "Luna_7_CPL", "partition-password", thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					testAccCheckHsmThaleslunagroupDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_Import(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := testAccHsmThaleslunagroupDevices("10.0.0.31", "1234567")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name, "Luna_7_CPL", "partition-password", thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccHsmThaleslunagroupImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+				ImportStateVerifyIgnore:              []string{"pass_phrase_version"},
+			},
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_Comment(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test_comment"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := testAccHsmThaleslunagroupDevices("10.0.0.31", "1234567")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupComment(name, thalesluna, "Thales Luna group for DNSSEC keys"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Thales Luna group for DNSSEC keys"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmThaleslunagroupComment(name, thalesluna, "Updated comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Updated comment"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_HsmVersion(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test_hsm_version"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := testAccHsmThaleslunagroupDevices("10.0.0.31", "1234567")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupHsmVersion(name, thalesluna, "Luna_6"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hsm_version", "Luna_6"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmThaleslunagroupHsmVersion(name, thalesluna, "Luna_7_CPL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hsm_version", "Luna_7_CPL"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_Name(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test_name"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	nameUpdate := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := testAccHsmThaleslunagroupDevices("10.0.0.31", "1234567")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupName(name, thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmThaleslunagroupName(nameUpdate, thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", nameUpdate),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_PassPhrase(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test_pass_phrase"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := testAccHsmThaleslunagroupDevices("10.0.0.31", "1234567")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupPassPhrase(name, thalesluna, "partition-password"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase_version", "1"),
+				),
+			},
+			// Same pass phrase does not change the version
+			{
+				Config: testAccHsmThaleslunagroupPassPhrase(name, thalesluna, "partition-password"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase_version", "1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmThaleslunagroupPassPhrase(name, thalesluna, "rotated-partition-password"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase_version", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "pass_phrase"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_Thalesluna(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test_thalesluna"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	serverCertFilePath := filepath.Join(getHsmThaleslunagroupTestDataPath(), "server.pem")
+	thalesluna := []map[string]any{
+		{
+			"name":                    "10.0.0.31",
+			"partition_serial_number": "1234567",
+			"server_cert_file_path":   serverCertFilePath,
+		},
+	}
+	thaleslunaUpdated := []map[string]any{
+		{
+			"name":                    "10.0.0.31",
+			"partition_serial_number": "1234567",
+			"disable":                 true,
+		},
+		{
+			"name":                    "10.0.0.32",
+			"partition_serial_number": "7654321",
+			"server_cert_file_path":   serverCertFilePath,
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupThalesluna(name, thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.name", "10.0.0.31"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.server_cert_file_path", serverCertFilePath),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmThaleslunagroupThalesluna(name, thaleslunaUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.disable", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "thalesluna.0.server_cert_file_path"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.1.name", "10.0.0.32"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.1.partition_serial_number", "7654321"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.1.server_cert_file_path", serverCertFilePath),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckHsmThaleslunagroupExists(ctx context.Context, resourceName string, v *security.HsmThaleslunagroup) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForHsmThaleslunagroup).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetHsmThaleslunagroupResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetHsmThaleslunagroupResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckHsmThaleslunagroupDestroy(ctx context.Context, v *security.HsmThaleslunagroup) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForHsmThaleslunagroup).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckHsmThaleslunagroupDisappears(ctx context.Context, v *security.HsmThaleslunagroup) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccHsmThaleslunagroupImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccHsmThaleslunagroupDevices(name, partitionSerialNumber string) []map[string]any {
+	return []map[string]any{
+		{
+			"name":                    name,
+			"partition_serial_number": partitionSerialNumber,
+		},
+	}
+}
+
+func testAccHsmThaleslunagroupBasicConfig(name, hsmVersion, passPhrase string, thalesluna []map[string]any) string {
+	thaleslunaStr := utils.ConvertSliceOfMapsToHCL(thalesluna)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_thaleslunagroup" "test" {
+	name = %q
+	hsm_version = %q
+	pass_phrase = %q
+	thalesluna = %s
+}
+`, name, hsmVersion, passPhrase, thaleslunaStr)
+}
+
+func testAccHsmThaleslunagroupComment(name string, thalesluna []map[string]any, comment string) string {
+	thaleslunaStr := utils.ConvertSliceOfMapsToHCL(thalesluna)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_thaleslunagroup" "test_comment" {
+	name = %q
+	hsm_version = "Luna_7_CPL"
+	pass_phrase = "partition-password"
+	thalesluna = %s
+	comment = %q
+}
+`, name, thaleslunaStr, comment)
+}
+
+func testAccHsmThaleslunagroupHsmVersion(name string, thalesluna []map[string]any, hsmVersion string) string {
+	thaleslunaStr := utils.ConvertSliceOfMapsToHCL(thalesluna)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_thaleslunagroup" "test_hsm_version" {
+	name = %q
+	hsm_version = %q
+	pass_phrase = "partition-password"
+	thalesluna = %s
+}
+`, name, hsmVersion, thaleslunaStr)
+}
+
+func testAccHsmThaleslunagroupName(name string, thalesluna []map[string]any) string {
+	thaleslunaStr := utils.ConvertSliceOfMapsToHCL(thalesluna)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_thaleslunagroup" "test_name" {
+	name = %q
+	hsm_version = "Luna_7_CPL"
+	pass_phrase = "partition-password"
+	thalesluna = %s
+}
+`, name, thaleslunaStr)
+}
+
+func testAccHsmThaleslunagroupPassPhrase(name string, thalesluna []map[string]any, passPhrase string) string {
+	thaleslunaStr := utils.ConvertSliceOfMapsToHCL(thalesluna)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_thaleslunagroup" "test_pass_phrase" {
+	name = %q
+	hsm_version = "Luna_7_CPL"
+	pass_phrase = %q
+	thalesluna = %s
+}
+`, name, passPhrase, thaleslunaStr)
+}
+
+func testAccHsmThaleslunagroupThalesluna(name string, thalesluna []map[string]any) string {
+	thaleslunaStr := utils.ConvertSliceOfMapsToHCL(thalesluna)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_thaleslunagroup" "test_thalesluna" {
+	name = %q
+	hsm_version = "Luna_7_CPL"
+	pass_phrase = "partition-password"
+	thalesluna = %s
+}
+`, name, thaleslunaStr)
+}
+
+func getHsmThaleslunagroupTestDataPath() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "../../testdata/nios_security_hsm_thaleslunagroup"
+	}
+	return filepath.Join(wd, "../../testdata/nios_security_hsm_thaleslunagroup")
+}
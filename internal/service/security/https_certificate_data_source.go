@@ -0,0 +1,126 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HttpsCertificateDataSource{}
+
+func NewHttpsCertificateDataSource() datasource.DataSource {
+	return &HttpsCertificateDataSource{}
+}
+
+// HttpsCertificateDataSource defines the data source implementation.
+type HttpsCertificateDataSource struct {
+	client *niosclient.APIClient
+}
+
+type HttpsCertificateDataSourceModel struct {
+	Member         types.String `tfsdk:"member"`
+	Certificate    types.String `tfsdk:"certificate"`
+	Subject        types.String `tfsdk:"subject"`
+	Issuer         types.String `tfsdk:"issuer"`
+	Serial         types.String `tfsdk:"serial"`
+	ValidNotAfter  types.Int64  `tfsdk:"valid_not_after"`
+	ValidNotBefore types.Int64  `tfsdk:"valid_not_before"`
+}
+
+func (d *HttpsCertificateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "security_https_certificate"
+}
+
+func (d *HttpsCertificateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the HTTPS certificate served by a member, including its expiry date.",
+		Attributes: map[string]schema.Attribute{
+			"member": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The host name of the member.",
+			},
+			"certificate": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The PEM encoded certificate served by the member.",
+			},
+			"subject": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The subject of the certificate.",
+			},
+			"issuer": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The issuer of the certificate.",
+			},
+			"serial": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The serial number of the certificate in hex format.",
+			},
+			"valid_not_after": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The date after which the certificate becomes invalid.",
+			},
+			"valid_not_before": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The date before which the certificate is not valid.",
+			},
+		},
+	}
+}
+
+func (d *HttpsCertificateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HttpsCertificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HttpsCertificateDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pemData, err := downloadCertificate(ctx, d.client, "ADMIN", data.Member.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HTTPS certificate of member %s, got error: %s", data.Member.ValueString(), err))
+		return
+	}
+	cert, err := parseCertificatePEM(pemData)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HTTPS certificate of member %s, got error: %s", data.Member.ValueString(), err))
+		return
+	}
+
+	data.Certificate = types.StringValue(string(pemData))
+	data.Subject = types.StringValue(cert.Subject.String())
+	data.Issuer = types.StringValue(cert.Issuer.String())
+	data.Serial = types.StringValue(certificateSerial(cert))
+	data.ValidNotAfter = types.Int64Value(cert.NotAfter.Unix())
+	data.ValidNotBefore = types.Int64Value(cert.NotBefore.Unix())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
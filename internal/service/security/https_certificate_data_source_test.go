@@ -0,0 +1,45 @@
+package security_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+func TestAccHttpsCertificateDataSource_basic(t *testing.T) {
+	dataSourceName := "data.nios_security_https_certificate.test"
+	member := utils.GetNIOSGridMasterHostName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHttpsCertificateDataSourceConfig(member),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "certificate", regexp.MustCompile(`-----BEGIN CERTIFICATE-----`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "subject"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "issuer"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "serial"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "valid_not_after"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "valid_not_before"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccHttpsCertificateDataSourceConfig(member string) string {
+	return fmt.Sprintf(`
+data "nios_security_https_certificate" "test" {
+  member = %q
+}
+`, member)
+}
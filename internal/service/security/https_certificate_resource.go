@@ -0,0 +1,256 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+)
+
+// httpsCertificateWaitTimeout is the time NIOS is given to restart the HTTPS service with an uploaded certificate.
+const httpsCertificateWaitTimeout = 5 * time.Minute
+
+var errHTTPSCertificatePending = errors.New("the member does not serve the uploaded certificate yet")
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HttpsCertificateResource{}
+var _ resource.ResourceWithImportState = &HttpsCertificateResource{}
+var _ resource.ResourceWithModifyPlan = &HttpsCertificateResource{}
+
+func NewHttpsCertificateResource() resource.Resource {
+	return &HttpsCertificateResource{}
+}
+
+// HttpsCertificateResource defines the resource implementation.
+type HttpsCertificateResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *HttpsCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "security_https_certificate"
+}
+
+func (r *HttpsCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the HTTPS certificate of a member. The certificate is uploaded again whenever the configured certificate differs from the one the member serves, so renewed certificates are installed by the next apply. Destroying the resource only removes it from the Terraform state, the member keeps serving the certificate.",
+		Attributes:          HttpsCertificateResourceSchemaAttributes,
+	}
+}
+
+func (r *HttpsCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans an upload when the configured certificate differs from the certificate served by the member.
+func (r *HttpsCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state HttpsCertificateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := readCertificatePEM(plan.Certificate, plan.CertificateFilePath)
+	if errors.Is(err, errCertificateNotAvailable) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate_file_path"), "Invalid Certificate", err.Error())
+		return
+	}
+	cert, err := parseCertificatePEM(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate"), "Invalid Certificate", err.Error())
+		return
+	}
+
+	if isSameCertificateSerial(state.Serial.ValueString(), certificateSerial(cert)) {
+		return
+	}
+
+	for _, attr := range []string{"subject", "issuer", "serial"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), types.StringUnknown())...)
+	}
+	for _, attr := range []string{"valid_not_after", "valid_not_before"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), types.Int64Unknown())...)
+	}
+}
+
+func (r *HttpsCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HttpsCertificateModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The private key is write-only and only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key"), &data.PrivateKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.install(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.PrivateKey = types.StringNull()
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HttpsCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HttpsCertificateModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pemData, err := downloadCertificate(ctx, r.client, "ADMIN", data.Member.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HTTPS certificate of member %s, got error: %s", data.Member.ValueString(), err))
+		return
+	}
+	cert, err := parseCertificatePEM(pemData)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HTTPS certificate of member %s, got error: %s", data.Member.ValueString(), err))
+		return
+	}
+
+	data.FlattenCertificate(cert)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HttpsCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state HttpsCertificateModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only upload the certificate if the member serves a different one
+	if !data.Serial.IsUnknown() {
+		data.Subject = state.Subject
+		data.Issuer = state.Issuer
+		data.Serial = state.Serial
+		data.ValidNotAfter = state.ValidNotAfter
+		data.ValidNotBefore = state.ValidNotBefore
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// The private key is write-only and only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key"), &data.PrivateKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.install(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.PrivateKey = types.StringNull()
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HttpsCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A member always serves an HTTPS certificate, so just clear state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *HttpsCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("member"), req, resp)
+}
+
+// install uploads the configured certificate to the member and waits until the member serves it.
+func (r *HttpsCertificateResource) install(ctx context.Context, data *HttpsCertificateModel, diags *diag.Diagnostics) {
+	member := data.Member.ValueString()
+
+	pemData, err := readCertificatePEM(data.Certificate, data.CertificateFilePath)
+	if errors.Is(err, errCertificateNotAvailable) {
+		err = fmt.Errorf("certificate file %s does not exist", data.CertificateFilePath.ValueString())
+	}
+	if err != nil {
+		diags.AddError("Invalid Certificate", err.Error())
+		return
+	}
+	cert, err := parseCertificatePEM(pemData)
+	if err != nil {
+		diags.AddError("Invalid Certificate", err.Error())
+		return
+	}
+	serial := certificateSerial(cert)
+
+	if err := uploadCertificate(ctx, r.client, "ADMIN", member, data.UploadData(pemData)); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to upload HTTPS certificate to member %s, got error: %s", member, err))
+		return
+	}
+
+	// The member restarts its HTTPS service with the new certificate, which may briefly interrupt connections
+	err = retry.DoWithTimeout(ctx, httpsCertificateWaitTimeout, func(err error) bool {
+		return errors.Is(err, errHTTPSCertificatePending) || retry.IsNetworkError(err)
+	}, func(ctx context.Context) (int, error) {
+		served, err := downloadCertificate(ctx, r.client, "ADMIN", member)
+		if err != nil {
+			return 0, err
+		}
+		servedCert, err := parseCertificatePEM(served)
+		if err != nil {
+			return 0, err
+		}
+		if !isSameCertificateSerial(certificateSerial(servedCert), serial) {
+			return 0, errHTTPSCertificatePending
+		}
+		return 0, nil
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("The HTTPS certificate with serial %s was uploaded to member %s, but the member does not serve it: %s", serial, member, err))
+		return
+	}
+
+	data.FlattenCertificate(cert)
+}
@@ -0,0 +1,111 @@
+package security_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO : The tests replace the HTTPS certificate of the Grid Master with a certificate issued by the test CA
+// in internal/testdata/nios_security_cacertificate
+
+func TestAccHttpsCertificateResource_basic(t *testing.T) {
+	var resourceName = "nios_security_https_certificate.test"
+	member := utils.GetNIOSGridMasterHostName()
+	testDataPath := getHttpsCertificateTestDataPath()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHttpsCertificateBasicConfig(member, filepath.Join(testDataPath, "server.pem"), filepath.Join(testDataPath, "server.key")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "member", member),
+					resource.TestCheckResourceAttr(resourceName, "subject", "CN=infoblox.localdomain,O=Example,C=US"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", "CN=Example Test Root CA 1,O=Example,C=US"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial"),
+					resource.TestCheckResourceAttrSet(resourceName, "valid_not_after"),
+					resource.TestCheckResourceAttrSet(resourceName, "valid_not_before"),
+					resource.TestCheckNoResourceAttr(resourceName, "private_key"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHttpsCertificateResource_CertificateFilePath(t *testing.T) {
+	var resourceName = "nios_security_https_certificate.test"
+	member := utils.GetNIOSGridMasterHostName()
+	testDataPath := getHttpsCertificateTestDataPath()
+	certificateFilePath := filepath.Join(t.TempDir(), "server.pem")
+	var serial string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				PreConfig: func() { copyHttpsCertificateTestFile(t, "server.pem", certificateFilePath) },
+				Config:    testAccHttpsCertificateBasicConfig(member, certificateFilePath, filepath.Join(testDataPath, "server.key")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "serial", func(value string) error {
+						serial = value
+						return nil
+					}),
+				),
+			},
+			// Upload the renewed certificate when the file changes
+			{
+				PreConfig: func() { copyHttpsCertificateTestFile(t, "server_updated.pem", certificateFilePath) },
+				Config:    testAccHttpsCertificateBasicConfig(member, certificateFilePath, filepath.Join(testDataPath, "server.key")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "serial", func(value string) error {
+						if value == serial {
+							return fmt.Errorf("expected the renewed certificate to be installed, serial is still %s", serial)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccHttpsCertificateBasicConfig(member, certificateFilePath, privateKeyFilePath string) string {
+	return fmt.Sprintf(`
+resource "nios_security_https_certificate" "test" {
+    member = %q
+    certificate_file_path = %q
+    private_key = file(%q)
+}
+`, member, certificateFilePath, privateKeyFilePath)
+}
+
+func getHttpsCertificateTestDataPath() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "../../testdata/nios_security_https_certificate"
+	}
+	return filepath.Join(wd, "../../testdata/nios_security_https_certificate")
+}
+
+func copyHttpsCertificateTestFile(t *testing.T, name, dest string) {
+	data, err := os.ReadFile(filepath.Join(getHttpsCertificateTestDataPath(), name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dest, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,37 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccLocaluserAuthserviceDataSource_basic(t *testing.T) {
+	dataSourceName := "data.nios_security_localuser_authservice.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocaluserAuthserviceDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccLocaluserAuthserviceDataSourceConfig() string {
+	return `
+data "nios_security_localuser_authservice" "test" {}
+`
+}
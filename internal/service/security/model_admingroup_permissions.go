@@ -0,0 +1,240 @@
+package security
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+// permissionObjectLookupFields maps the object types that can be looked up by name to the field holding
+// the name and the field holding the view or network view.
+var permissionObjectLookupFields = map[string][2]string{
+	"zone_auth":            {"fqdn", "view"},
+	"zone_delegated":       {"fqdn", "view"},
+	"zone_forward":         {"fqdn", "view"},
+	"zone_rp":              {"fqdn", "view"},
+	"zone_stub":            {"fqdn", "view"},
+	"network":              {"network", "network_view"},
+	"networkcontainer":     {"network", "network_view"},
+	"ipv6network":          {"network", "network_view"},
+	"ipv6networkcontainer": {"network", "network_view"},
+	"sharednetwork":        {"name", "network_view"},
+	"ipv6sharednetwork":    {"name", "network_view"},
+	"networkview":          {"name", ""},
+	"view":                 {"name", ""},
+	"member":               {"host_name", ""},
+	"dtc:lbdn":             {"name", ""},
+	"dtc:pool":             {"name", ""},
+	"dtc:server":           {"name", ""},
+}
+
+type AdmingroupPermissionsModel struct {
+	Group       types.String `tfsdk:"group"`
+	Role        types.String `tfsdk:"role"`
+	Permissions types.List   `tfsdk:"permissions"`
+}
+
+type AdmingroupPermissionsPermissionsModel struct {
+	Ref          types.String `tfsdk:"ref"`
+	Object       types.String `tfsdk:"object"`
+	ObjectType   types.String `tfsdk:"object_type"`
+	ObjectName   types.String `tfsdk:"object_name"`
+	ObjectView   types.String `tfsdk:"object_view"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Permission   types.String `tfsdk:"permission"`
+}
+
+var AdmingroupPermissionsPermissionsAttrTypes = map[string]attr.Type{
+	"ref":           types.StringType,
+	"object":        types.StringType,
+	"object_type":   types.StringType,
+	"object_name":   types.StringType,
+	"object_view":   types.StringType,
+	"resource_type": types.StringType,
+	"permission":    types.StringType,
+}
+
+var AdmingroupPermissionsPermissionsResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the permission.",
+	},
+	"object": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("object_name")),
+		},
+		MarkdownDescription: "A reference to the WAPI object this permission applies to. Computed when the object is looked up by `object_name`.",
+	},
+	"object_type": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(permissionObjectLookupTypes()...),
+			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("object_name")),
+		},
+		MarkdownDescription: "The WAPI object type of the object to look up by name, for example `zone_auth`, `network` or `view`.",
+	},
+	"object_name": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("object_type")),
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The name of the object this permission applies to: the FQDN of a zone, the address of a network in CIDR format, the host name of a member or the name of any other object.",
+	},
+	"object_view": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("object_name")),
+		},
+		MarkdownDescription: "The DNS view of a zone, or the network view of a network, to look up `object_name` in. If not set, the object must be unique across views.",
+	},
+	"resource_type": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(permissionResourceTypes...),
+		},
+		MarkdownDescription: "The type of resource this permission applies to. If an object is set, the permission applies to child objects of this type within the object.",
+	},
+	"permission": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("DENY", "READ", "WRITE"),
+		},
+		MarkdownDescription: "The type of permission.",
+	},
+}
+
+var AdmingroupPermissionsResourceSchemaAttributes = map[string]schema.Attribute{
+	"group": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRoot("group"),
+				path.MatchRoot("role"),
+			),
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The name of the admin group whose permissions are managed.",
+	},
+	"role": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The name of the admin role whose permissions are managed.",
+	},
+	"permissions": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: AdmingroupPermissionsPermissionsResourceSchemaAttributes,
+		},
+		Required: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "The complete set of permissions of the admin group or role. Permissions that exist on the Grid but are not listed here are removed. Each permission must set an object, a resource type or both.",
+	},
+}
+
+func permissionObjectLookupTypes() []string {
+	lookupTypes := make([]string, 0, len(permissionObjectLookupFields))
+	for t := range permissionObjectLookupFields {
+		lookupTypes = append(lookupTypes, t)
+	}
+	sort.Strings(lookupTypes)
+	return lookupTypes
+}
+
+func (m *AdmingroupPermissionsPermissionsModel) Expand(ctx context.Context, group, role types.String, diags *diag.Diagnostics) *security.Permission {
+	if m == nil {
+		return nil
+	}
+	to := &security.Permission{
+		Group:        flex.ExpandStringPointer(group),
+		Role:         flex.ExpandStringPointer(role),
+		Object:       flex.ExpandStringPointer(m.Object),
+		Permission:   flex.ExpandStringPointer(m.Permission),
+		ResourceType: flex.ExpandStringPointer(m.ResourceType),
+	}
+	return to
+}
+
+func FlattenAdmingroupPermissionsPermissions(ctx context.Context, from *security.Permission, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(AdmingroupPermissionsPermissionsAttrTypes)
+	}
+	m := AdmingroupPermissionsPermissionsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, AdmingroupPermissionsPermissionsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *AdmingroupPermissionsPermissionsModel) Flatten(ctx context.Context, from *security.Permission, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = AdmingroupPermissionsPermissionsModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Object = flex.FlattenStringPointer(from.Object)
+	m.Permission = flex.FlattenStringPointer(from.Permission)
+	m.ResourceType = flex.FlattenStringPointer(from.ResourceType)
+}
+
+// FlattenPermissions sets the permissions from the Grid, keeping the order and the name lookups of the prior
+// permissions for the ones that still exist. Permissions that are not in the prior list are appended.
+func (m *AdmingroupPermissionsModel) FlattenPermissions(ctx context.Context, prior []AdmingroupPermissionsPermissionsModel, from []security.Permission, diags *diag.Diagnostics) {
+	remaining := slices.Clone(from)
+	result := make([]AdmingroupPermissionsPermissionsModel, 0, len(from))
+
+	for _, p := range prior {
+		i := slices.IndexFunc(remaining, func(perm security.Permission) bool {
+			return permissionKey(perm.GetObject(), perm.GetResourceType()) == permissionKey(p.Object.ValueString(), p.ResourceType.ValueString())
+		})
+		if i < 0 {
+			continue
+		}
+		perm := p
+		perm.Flatten(ctx, &remaining[i], diags)
+		result = append(result, perm)
+		remaining = slices.Delete(remaining, i, i+1)
+	}
+
+	for i := range remaining {
+		perm := AdmingroupPermissionsPermissionsModel{
+			ObjectType: types.StringNull(),
+			ObjectName: types.StringNull(),
+			ObjectView: types.StringNull(),
+		}
+		perm.Flatten(ctx, &remaining[i], diags)
+		result = append(result, perm)
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AdmingroupPermissionsPermissionsAttrTypes}, result)
+	diags.Append(d...)
+	m.Permissions = list
+}
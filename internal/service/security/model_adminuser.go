@@ -3,6 +3,7 @@ package security
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -37,9 +38,13 @@ type AdminuserModel struct {
 	EnableCertificateAuthentication types.Bool   `tfsdk:"enable_certificate_authentication"`
 	ExtAttrs                        types.Map    `tfsdk:"extattrs"`
 	ExtAttrsAll                     types.Map    `tfsdk:"extattrs_all"`
+	Locked                          types.Bool   `tfsdk:"locked"`
 	Name                            types.String `tfsdk:"name"`
 	Password                        types.String `tfsdk:"password"`
+	PasswordExpiryTime              types.Int64  `tfsdk:"password_expiry_time"`
+	PasswordLastChanged             types.Int64  `tfsdk:"password_last_changed"`
 	PasswordRevision                types.Int64  `tfsdk:"password_revision"`
+	PasswordRotationDays            types.Int64  `tfsdk:"password_rotation_days"`
 	SshKeys                         types.List   `tfsdk:"ssh_keys"`
 	Status                          types.String `tfsdk:"status"`
 	TimeZone                        types.String `tfsdk:"time_zone"`
@@ -60,9 +65,13 @@ var AdminuserAttrTypes = map[string]attr.Type{
 	"enable_certificate_authentication": types.BoolType,
 	"extattrs":                          types.MapType{ElemType: types.StringType},
 	"extattrs_all":                      types.MapType{ElemType: types.StringType},
+	"locked":                            types.BoolType,
 	"name":                              types.StringType,
 	"password":                          types.StringType,
+	"password_expiry_time":              types.Int64Type,
+	"password_last_changed":             types.Int64Type,
 	"password_revision":                 types.Int64Type,
+	"password_rotation_days":            types.Int64Type,
 	"ssh_keys":                          types.ListType{ElemType: types.ObjectType{AttrTypes: AdminuserSshKeysAttrTypes}},
 	"status":                            types.StringType,
 	"time_zone":                         types.StringType,
@@ -157,6 +166,10 @@ var AdminuserResourceSchemaAttributes = map[string]schema.Attribute{
 			importmod.AssociateInternalId(),
 		},
 	},
+	"locked": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines whether the admin user is locked out after too many failed login attempts.",
+	},
 	"name": schema.StringAttribute{
 		Required:            true,
 		MarkdownDescription: "The name of the admin user.",
@@ -164,10 +177,19 @@ var AdminuserResourceSchemaAttributes = map[string]schema.Attribute{
 	"password": schema.StringAttribute{
 		Required:  true,
 		WriteOnly: true,
-		Validators: []validator.String{
-			customvalidator.IsStrongPassword(),
+		MarkdownDescription: "The password for the administrator to use when logging in. A changed password is validated against " +
+			"the password policy of the Grid during plan.",
+	},
+	"password_expiry_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time the password expires according to the password expiry setting of the Admin Group, or of the Grid if the Admin Group does not override it. Not set if passwords do not expire or the password was not set by Terraform.",
+	},
+	"password_last_changed": schema.Int64Attribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
 		},
-		MarkdownDescription: "The password for the administrator to use when logging in.",
+		MarkdownDescription: "The time the password was last set by Terraform.",
 	},
 	"password_revision": schema.Int64Attribute{
 		Computed:            true,
@@ -176,6 +198,14 @@ var AdminuserResourceSchemaAttributes = map[string]schema.Attribute{
 			int64planmodifier.UseStateForUnknown(),
 		},
 	},
+	"password_rotation_days": schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		MarkdownDescription: "Sets the password again when it expires within the given number of days, which restarts the password expiry period. Must be less than the password expiry period. " +
+			"If the Grid keeps a password history, configure a new password instead, as the Grid rejects a password that was used before.",
+	},
 	"ssh_keys": schema.ListNestedAttribute{
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: AdminuserSshKeysResourceSchemaAttributes,
@@ -271,6 +301,7 @@ func (m *AdminuserModel) Flatten(ctx context.Context, from *security.Adminuser,
 	m.Name = flex.FlattenStringPointer(from.Name)
 	m.SshKeys = flex.FlattenFrameworkListNestedBlock(ctx, from.SshKeys, AdminuserSshKeysAttrTypes, diags, FlattenAdminuserSshKeys)
 	m.Status = flex.FlattenStringPointer(from.Status)
+	m.Locked = types.BoolValue(from.GetStatus() == "LOCKED")
 	m.TimeZone = flex.FlattenStringPointer(from.TimeZone)
 	m.UseSshKeys = types.BoolPointerValue(from.UseSshKeys)
 	m.UseTimeZone = types.BoolPointerValue(from.UseTimeZone)
@@ -0,0 +1,149 @@
+package security
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type AuthpolicyModel struct {
+	Ref          types.String `tfsdk:"ref"`
+	AdminGroups  types.List   `tfsdk:"admin_groups"`
+	AuthServices types.List   `tfsdk:"auth_services"`
+	DefaultGroup types.String `tfsdk:"default_group"`
+	UsageType    types.String `tfsdk:"usage_type"`
+}
+
+var AuthpolicyAttrTypes = map[string]attr.Type{
+	"ref":           types.StringType,
+	"admin_groups":  types.ListType{ElemType: types.StringType},
+	"auth_services": types.ListType{ElemType: types.StringType},
+	"default_group": types.StringType,
+	"usage_type":    types.StringType,
+}
+
+var AuthpolicyResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"admin_groups": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.List{
+			listvalidator.UniqueValues(),
+		},
+		MarkdownDescription: "The names of the local admin groups that remote admin groups are mapped to. Remote admins are assigned to the first group in the list they are a member of.",
+	},
+	"auth_services": schema.ListAttribute{
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+		MarkdownDescription: "The references of the authentication services, in the order they are tried when an admin logs in. The list must include the Local User Authentication Service, see the `nios_security_localuser_authservice` data source.",
+	},
+	"default_group": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The name of the admin group that remote admins are assigned to when they are not a member of any of the admin groups.",
+	},
+	"usage_type": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("FULL"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("AUTH_ONLY", "FULL"),
+		},
+		MarkdownDescription: "Remote policies usage. `FULL` uses the remote services for authentication and authorization, `AUTH_ONLY` uses them for authentication only.",
+	},
+}
+
+func (m *AuthpolicyModel) Expand(ctx context.Context, diags *diag.Diagnostics) *security.Authpolicy {
+	if m == nil {
+		return nil
+	}
+	to := &security.Authpolicy{
+		AdminGroups:  flex.ExpandFrameworkListString(ctx, m.AdminGroups, diags),
+		AuthServices: flex.ExpandFrameworkListString(ctx, m.AuthServices, diags),
+		DefaultGroup: flex.ExpandStringPointer(m.DefaultGroup),
+		UsageType:    flex.ExpandStringPointer(m.UsageType),
+	}
+	return to
+}
+
+func FlattenAuthpolicy(ctx context.Context, from *security.Authpolicy, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(AuthpolicyAttrTypes)
+	}
+	m := AuthpolicyModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, AuthpolicyAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *AuthpolicyModel) Flatten(ctx context.Context, from *security.Authpolicy, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = AuthpolicyModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.AdminGroups = flex.FlattenFrameworkListString(ctx, from.AdminGroups, diags)
+	m.AuthServices = flattenAuthServices(ctx, m.AuthServices, from.AuthServices, diags)
+	m.DefaultGroup = flex.FlattenStringPointer(from.DefaultGroup)
+	m.UsageType = flex.FlattenStringPointer(from.UsageType)
+}
+
+// flattenAuthServices keeps the configured references when they point to the same services in the same order,
+// as the name part of a reference returned by NIOS may be encoded differently.
+func flattenAuthServices(ctx context.Context, prior types.List, from []string, diags *diag.Diagnostics) types.List {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorRefs []string
+		diags.Append(prior.ElementsAs(ctx, &priorRefs, false)...)
+		if len(priorRefs) == len(from) {
+			same := true
+			for i := range from {
+				if authServiceRefID(priorRefs[i]) != authServiceRefID(from[i]) {
+					same = false
+					break
+				}
+			}
+			if same {
+				return prior
+			}
+		}
+	}
+	return flex.FlattenFrameworkListString(ctx, from, diags)
+}
+
+// authServiceRefID returns the object type and ID of a reference, without the name part,
+// for example ad_auth_service/b25lLmFkX2F1dGhfc2VydmljZSRhZA for ad_auth_service/b25lLmFkX2F1dGhfc2VydmljZSRhZA:ad.
+func authServiceRefID(ref string) string {
+	ref = strings.Trim(ref, "/")
+	slash := strings.Index(ref, "/")
+	if slash < 0 {
+		return ref
+	}
+	if colon := strings.Index(ref[slash:], ":"); colon >= 0 {
+		return ref[:slash+colon]
+	}
+	return ref
+}
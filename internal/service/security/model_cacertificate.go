@@ -0,0 +1,156 @@
+package security
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type CacertificateModel struct {
+	Ref                 types.String `tfsdk:"ref"`
+	DistinguishedName   types.String `tfsdk:"distinguished_name"`
+	Issuer              types.String `tfsdk:"issuer"`
+	Serial              types.String `tfsdk:"serial"`
+	UsedBy              types.String `tfsdk:"used_by"`
+	ValidNotAfter       types.Int64  `tfsdk:"valid_not_after"`
+	ValidNotBefore      types.Int64  `tfsdk:"valid_not_before"`
+	Certificate         types.String `tfsdk:"certificate"`
+	CertificateFilePath types.String `tfsdk:"certificate_file_path"`
+	Member              types.String `tfsdk:"member"`
+}
+
+var CacertificateAttrTypes = map[string]attr.Type{
+	"ref":                   types.StringType,
+	"distinguished_name":    types.StringType,
+	"issuer":                types.StringType,
+	"serial":                types.StringType,
+	"used_by":               types.StringType,
+	"valid_not_after":       types.Int64Type,
+	"valid_not_before":      types.Int64Type,
+	"certificate":           types.StringType,
+	"certificate_file_path": types.StringType,
+	"member":                types.StringType,
+}
+
+var CacertificateResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"distinguished_name": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The certificate subject name.",
+	},
+	"issuer": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The certificate issuer subject name.",
+	},
+	"serial": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The certificate serial number in hex format.",
+	},
+	"used_by": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "Information about the CA certificate usage.",
+	},
+	"valid_not_after": schema.Int64Attribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The date after which the certificate becomes invalid.",
+	},
+	"valid_not_before": schema.Int64Attribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The date before which the certificate is not valid.",
+	},
+	"certificate": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRoot("certificate"),
+				path.MatchRoot("certificate_file_path"),
+			),
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The PEM encoded CA certificate to upload.",
+	},
+	"certificate_file_path": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The local path of the PEM encoded CA certificate to upload. The certificate is replaced when the file contains a different certificate.",
+	},
+	"member": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The host name of the member to upload the certificate through. CA certificates are trusted by the whole Grid, whatever member they are uploaded through.",
+	},
+}
+
+func FlattenCacertificate(ctx context.Context, from *security.Cacertificate, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(CacertificateAttrTypes)
+	}
+	m := CacertificateModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, CacertificateAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *CacertificateModel) Flatten(ctx context.Context, from *security.Cacertificate, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = CacertificateModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.DistinguishedName = flex.FlattenStringPointer(from.DistinguishedName)
+	m.Issuer = flex.FlattenStringPointer(from.Issuer)
+	m.Serial = flex.FlattenStringPointer(from.Serial)
+	m.UsedBy = flex.FlattenStringPointer(from.UsedBy)
+	m.ValidNotAfter = flex.FlattenInt64Pointer(from.ValidNotAfter)
+	m.ValidNotBefore = flex.FlattenInt64Pointer(from.ValidNotBefore)
+}
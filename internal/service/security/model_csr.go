@@ -0,0 +1,265 @@
+package security
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type CsrModel struct {
+	Member                  types.String `tfsdk:"member"`
+	CertificateUsage        types.String `tfsdk:"certificate_usage"`
+	Cn                      types.String `tfsdk:"cn"`
+	Algorithm               types.String `tfsdk:"algorithm"`
+	KeySize                 types.Int64  `tfsdk:"key_size"`
+	Comment                 types.String `tfsdk:"comment"`
+	Country                 types.String `tfsdk:"country"`
+	State                   types.String `tfsdk:"state"`
+	Locality                types.String `tfsdk:"locality"`
+	Org                     types.String `tfsdk:"org"`
+	OrgUnit                 types.String `tfsdk:"org_unit"`
+	Email                   types.String `tfsdk:"email"`
+	SubjectAlternativeNames types.List   `tfsdk:"subject_alternative_names"`
+	Triggers                types.Map    `tfsdk:"triggers"`
+	Csr                     types.String `tfsdk:"csr"`
+}
+
+type CsrSubjectAlternativeNamesModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+var CsrSubjectAlternativeNamesAttrTypes = map[string]attr.Type{
+	"type":  types.StringType,
+	"value": types.StringType,
+}
+
+var CsrSubjectAlternativeNamesResourceSchemaAttributes = map[string]schema.Attribute{
+	"type": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("DNS", "EMAIL", "IP", "URI"),
+		},
+		MarkdownDescription: "The type of the subject alternative name.",
+	},
+	"value": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The value of the subject alternative name.",
+	},
+}
+
+// listRequiresReplace replaces the resource when the list changes.
+type listRequiresReplace struct{}
+
+func (m listRequiresReplace) Description(ctx context.Context) string {
+	return "If the value of this attribute changes, Terraform will destroy and recreate the resource."
+}
+
+func (m listRequiresReplace) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m listRequiresReplace) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	if req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+	resp.RequiresReplace = true
+}
+
+var CsrResourceSchemaAttributes = map[string]schema.Attribute{
+	"member": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The host name of the member that generates the key pair and the CSR.",
+	},
+	"certificate_usage": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("ADMIN"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.OneOf("ADMIN", "CAPTIVE_PORTAL", "IFMAP_DHCP", "SFNT_CLIENT_CERT"),
+		},
+		MarkdownDescription: "The purpose of the certificate. `ADMIN` is the HTTPS certificate of the member.",
+	},
+	"cn": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The common name of the certificate subject.",
+	},
+	"algorithm": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("SHA-256"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.OneOf("SHA-1", "SHA-256", "SHA-384", "SHA-512"),
+		},
+		MarkdownDescription: "The digest algorithm used to sign the CSR.",
+	},
+	"key_size": schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(2048),
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Int64{
+			int64validator.OneOf(1024, 2048, 4096),
+		},
+		MarkdownDescription: "The size of the generated RSA key in bits.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The descriptive comment of the CSR.",
+	},
+	"country": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(2, 2),
+		},
+		MarkdownDescription: "The two letter country code of the certificate subject.",
+	},
+	"state": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The state or province of the certificate subject.",
+	},
+	"locality": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The locality of the certificate subject.",
+	},
+	"org": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The organization of the certificate subject.",
+	},
+	"org_unit": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The organizational unit of the certificate subject.",
+	},
+	"email": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The e-mail address of the certificate subject.",
+	},
+	"subject_alternative_names": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: CsrSubjectAlternativeNamesResourceSchemaAttributes,
+		},
+		Optional: true,
+		PlanModifiers: []planmodifier.List{
+			listRequiresReplace{},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "The subject alternative names of the certificate.",
+	},
+	"triggers": schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "Arbitrary values that generate a new key pair and CSR when they change, e.g. the ID of a `time_rotating` resource to renew the certificate periodically.",
+	},
+	"csr": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The PEM encoded CSR generated by the member. Have it signed by a CA and install the signed certificate with the `nios_security_https_certificate` resource.",
+	},
+}
+
+// Args returns the arguments of the fileop generatecsr function.
+func (m *CsrModel) Args(ctx context.Context, diags *diag.Diagnostics) map[string]any {
+	args := map[string]any{
+		"member":            m.Member.ValueString(),
+		"certificate_usage": m.CertificateUsage.ValueString(),
+		"cn":                m.Cn.ValueString(),
+		"algorithm":         m.Algorithm.ValueString(),
+		"key_size":          m.KeySize.ValueInt64(),
+	}
+	for name, value := range map[string]types.String{
+		"comment":  m.Comment,
+		"country":  m.Country,
+		"state":    m.State,
+		"locality": m.Locality,
+		"org":      m.Org,
+		"org_unit": m.OrgUnit,
+		"email":    m.Email,
+	} {
+		if !value.IsNull() && !value.IsUnknown() {
+			args[name] = value.ValueString()
+		}
+	}
+
+	if !m.SubjectAlternativeNames.IsNull() && !m.SubjectAlternativeNames.IsUnknown() {
+		var sans []CsrSubjectAlternativeNamesModel
+		diags.Append(m.SubjectAlternativeNames.ElementsAs(ctx, &sans, false)...)
+		subjectAlternativeNames := make([]map[string]string, 0, len(sans))
+		for _, san := range sans {
+			subjectAlternativeNames = append(subjectAlternativeNames, map[string]string{
+				"type":  san.Type.ValueString(),
+				"value": san.Value.ValueString(),
+			})
+		}
+		args["subject_alternative_names"] = subjectAlternativeNames
+	}
+	return args
+}
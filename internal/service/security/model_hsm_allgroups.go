@@ -0,0 +1,25 @@
+package security
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type HsmAllgroupsModel struct {
+	Ref    types.String `tfsdk:"ref"`
+	Groups types.List   `tfsdk:"groups"`
+}
+
+type HsmAllgroupsGroupModel struct {
+	Ref    types.String `tfsdk:"ref"`
+	Type   types.String `tfsdk:"type"`
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+}
+
+var HsmAllgroupsGroupAttrTypes = map[string]attr.Type{
+	"ref":    types.StringType,
+	"type":   types.StringType,
+	"name":   types.StringType,
+	"status": types.StringType,
+}
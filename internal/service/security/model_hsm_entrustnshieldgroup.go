@@ -0,0 +1,175 @@
+package security
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type HsmEntrustnshieldgroupModel struct {
+	Ref               types.String        `tfsdk:"ref"`
+	CardName          types.String        `tfsdk:"card_name"`
+	Comment           types.String        `tfsdk:"comment"`
+	EntrustnshieldHsm types.List          `tfsdk:"entrustnshield_hsm"`
+	KeyServerIp       iptypes.IPv4Address `tfsdk:"key_server_ip"`
+	KeyServerPort     types.Int64         `tfsdk:"key_server_port"`
+	Name              types.String        `tfsdk:"name"`
+	PassPhrase        types.String        `tfsdk:"pass_phrase"`
+	PassPhraseVersion types.Int64         `tfsdk:"pass_phrase_version"`
+	Protection        types.String        `tfsdk:"protection"`
+	Status            types.String        `tfsdk:"status"`
+}
+
+var HsmEntrustnshieldgroupAttrTypes = map[string]attr.Type{
+	"ref":                 types.StringType,
+	"card_name":           types.StringType,
+	"comment":             types.StringType,
+	"entrustnshield_hsm":  types.ListType{ElemType: types.ObjectType{AttrTypes: HsmEntrustnshieldgroupEntrustnshieldHsmAttrTypes}},
+	"key_server_ip":       iptypes.IPv4AddressType{},
+	"key_server_port":     types.Int64Type,
+	"name":                types.StringType,
+	"pass_phrase":         types.StringType,
+	"pass_phrase_version": types.Int64Type,
+	"protection":          types.StringType,
+	"status":              types.StringType,
+}
+
+var HsmEntrustnshieldgroupResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"card_name": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The Entrust nShield HSM softcard name. Required if `protection` is set to `SOFTCARD`.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The Entrust nShield HSM group comment.",
+	},
+	"entrustnshield_hsm": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: HsmEntrustnshieldgroupEntrustnshieldHsmResourceSchemaAttributes,
+		},
+		Required: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "The list of Entrust nShield HSM devices.",
+	},
+	"key_server_ip": schema.StringAttribute{
+		CustomType:          iptypes.IPv4AddressType{},
+		Required:            true,
+		MarkdownDescription: "The remote file server (RFS) IPv4 Address.",
+	},
+	"key_server_port": schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(9004),
+		Validators: []validator.Int64{
+			int64validator.Between(1, 65535),
+		},
+		MarkdownDescription: "The remote file server (RFS) port.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The Entrust nShield HSM group name.",
+	},
+	"pass_phrase": schema.StringAttribute{
+		Optional:            true,
+		WriteOnly:           true,
+		Sensitive:           true,
+		MarkdownDescription: "The password phrase used to unlock the Entrust nShield HSM keystore. Required if `protection` is set to `SOFTCARD`. This is a write-only attribute.",
+	},
+	"pass_phrase_version": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Internal version incremented when pass_phrase field changes.",
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	},
+	"protection": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("MODULE", "SOFTCARD"),
+		},
+		MarkdownDescription: "The level of protection that the HSM group uses for the DNSSEC key data. Valid values are: `MODULE` and `SOFTCARD`.",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The status of all Entrust nShield HSM devices in the group.",
+	},
+}
+
+func (m *HsmEntrustnshieldgroupModel) Expand(ctx context.Context, diags *diag.Diagnostics) *security.HsmEntrustnshieldgroup {
+	if m == nil {
+		return nil
+	}
+	to := &security.HsmEntrustnshieldgroup{
+		CardName:          flex.ExpandStringPointer(m.CardName),
+		Comment:           flex.ExpandStringPointer(m.Comment),
+		EntrustnshieldHsm: flex.ExpandFrameworkListNestedBlock(ctx, m.EntrustnshieldHsm, diags, ExpandHsmEntrustnshieldgroupEntrustnshieldHsm),
+		KeyServerIp:       flex.ExpandIPv4Address(m.KeyServerIp),
+		KeyServerPort:     flex.ExpandInt64Pointer(m.KeyServerPort),
+		Name:              flex.ExpandStringPointer(m.Name),
+		Protection:        flex.ExpandStringPointer(m.Protection),
+	}
+	return to
+}
+
+func FlattenHsmEntrustnshieldgroup(ctx context.Context, from *security.HsmEntrustnshieldgroup, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(HsmEntrustnshieldgroupAttrTypes)
+	}
+	m := HsmEntrustnshieldgroupModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, HsmEntrustnshieldgroupAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *HsmEntrustnshieldgroupModel) Flatten(ctx context.Context, from *security.HsmEntrustnshieldgroup, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = HsmEntrustnshieldgroupModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.CardName = flex.FlattenStringPointer(from.CardName)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.EntrustnshieldHsm = flex.FlattenFrameworkListNestedBlock(ctx, from.EntrustnshieldHsm, HsmEntrustnshieldgroupEntrustnshieldHsmAttrTypes, diags, FlattenHsmEntrustnshieldgroupEntrustnshieldHsm)
+	m.KeyServerIp = flex.FlattenIPv4Address(from.KeyServerIp)
+	m.KeyServerPort = flex.FlattenInt64Pointer(from.KeyServerPort)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Protection = flex.FlattenStringPointer(from.Protection)
+	m.Status = flex.FlattenStringPointer(from.Status)
+}
@@ -0,0 +1,164 @@
+package security
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type HsmThaleslunagroupModel struct {
+	Ref               types.String `tfsdk:"ref"`
+	Comment           types.String `tfsdk:"comment"`
+	GroupSn           types.String `tfsdk:"group_sn"`
+	HsmVersion        types.String `tfsdk:"hsm_version"`
+	Name              types.String `tfsdk:"name"`
+	PassPhrase        types.String `tfsdk:"pass_phrase"`
+	PassPhraseVersion types.Int64  `tfsdk:"pass_phrase_version"`
+	Status            types.String `tfsdk:"status"`
+	Thalesluna        types.List   `tfsdk:"thalesluna"`
+}
+
+var HsmThaleslunagroupAttrTypes = map[string]attr.Type{
+	"ref":                 types.StringType,
+	"comment":             types.StringType,
+	"group_sn":            types.StringType,
+	"hsm_version":         types.StringType,
+	"name":                types.StringType,
+	"pass_phrase":         types.StringType,
+	"pass_phrase_version": types.Int64Type,
+	"status":              types.StringType,
+	"thalesluna":          types.ListType{ElemType: types.ObjectType{AttrTypes: HsmThaleslunagroupThaleslunaAttrTypes}},
+}
+
+var HsmThaleslunagroupResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The HSM Thales Luna group comment.",
+	},
+	"group_sn": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The HSM Thales Luna group serial number.",
+	},
+	"hsm_version": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("Luna_5", "Luna_6", "Luna_7_CPL"),
+		},
+		MarkdownDescription: "The HSM Thales Luna version. Valid values are: `Luna_5`, `Luna_6` and `Luna_7_CPL`.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The HSM Thales Luna group name.",
+	},
+	"pass_phrase": schema.StringAttribute{
+		Required:            true,
+		WriteOnly:           true,
+		Sensitive:           true,
+		MarkdownDescription: "The partition password used to unlock the HSM Thales Luna keystore. This is a write-only attribute.",
+	},
+	"pass_phrase_version": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Internal version incremented when pass_phrase field changes.",
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The status of all HSM Thales Luna devices in the group.",
+	},
+	"thalesluna": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: HsmThaleslunagroupThaleslunaResourceSchemaAttributes,
+		},
+		Required: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "The list of HSM Thales Luna devices.",
+	},
+}
+
+func (m *HsmThaleslunagroupModel) Expand(ctx context.Context, diags *diag.Diagnostics) *security.HsmThaleslunagroup {
+	if m == nil {
+		return nil
+	}
+	to := &security.HsmThaleslunagroup{
+		Comment:    flex.ExpandStringPointer(m.Comment),
+		HsmVersion: flex.ExpandStringPointer(m.HsmVersion),
+		Name:       flex.ExpandStringPointer(m.Name),
+		Thalesluna: flex.ExpandFrameworkListNestedBlock(ctx, m.Thalesluna, diags, ExpandHsmThaleslunagroupThalesluna),
+	}
+	return to
+}
+
+func FlattenHsmThaleslunagroup(ctx context.Context, from *security.HsmThaleslunagroup, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(HsmThaleslunagroupAttrTypes)
+	}
+	m := HsmThaleslunagroupModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, HsmThaleslunagroupAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *HsmThaleslunagroupModel) Flatten(ctx context.Context, from *security.HsmThaleslunagroup, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = HsmThaleslunagroupModel{}
+	}
+	serverCertFilePaths := m.serverCertFilePaths(ctx, diags)
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.GroupSn = flex.FlattenStringPointer(from.GroupSn)
+	m.HsmVersion = flex.FlattenStringPointer(from.HsmVersion)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Status = flex.FlattenStringPointer(from.Status)
+	m.Thalesluna = flex.FlattenFrameworkListNestedBlock(ctx, from.Thalesluna, HsmThaleslunagroupThaleslunaAttrTypes, diags, func(ctx context.Context, from *security.HsmThaleslunagroupThalesluna, diags *diag.Diagnostics) types.Object {
+		return FlattenHsmThaleslunagroupThalesluna(ctx, from, serverCertFilePaths, diags)
+	})
+}
+
+// serverCertFilePaths returns the configured server certificate file path of every device by device name,
+// as NIOS only returns the upload token of the certificate.
+func (m *HsmThaleslunagroupModel) serverCertFilePaths(ctx context.Context, diags *diag.Diagnostics) map[string]types.String {
+	paths := map[string]types.String{}
+	if m.Thalesluna.IsNull() || m.Thalesluna.IsUnknown() {
+		return paths
+	}
+	var devices []HsmThaleslunagroupThaleslunaModel
+	diags.Append(m.Thalesluna.ElementsAs(ctx, &devices, false)...)
+	for _, device := range devices {
+		paths[device.Name.ValueString()] = device.ServerCertFilePath
+	}
+	return paths
+}
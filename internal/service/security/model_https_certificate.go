@@ -0,0 +1,122 @@
+package security
+
+import (
+	"crypto/x509"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type HttpsCertificateModel struct {
+	Member              types.String `tfsdk:"member"`
+	Certificate         types.String `tfsdk:"certificate"`
+	CertificateFilePath types.String `tfsdk:"certificate_file_path"`
+	PrivateKey          types.String `tfsdk:"private_key"`
+	Subject             types.String `tfsdk:"subject"`
+	Issuer              types.String `tfsdk:"issuer"`
+	Serial              types.String `tfsdk:"serial"`
+	ValidNotAfter       types.Int64  `tfsdk:"valid_not_after"`
+	ValidNotBefore      types.Int64  `tfsdk:"valid_not_before"`
+}
+
+var HttpsCertificateResourceSchemaAttributes = map[string]schema.Attribute{
+	"member": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The host name of the member to install the HTTPS certificate on.",
+	},
+	"certificate": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRoot("certificate"),
+				path.MatchRoot("certificate_file_path"),
+			),
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The PEM encoded certificate, optionally followed by its intermediate CA certificates.",
+	},
+	"certificate_file_path": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The local path of the PEM encoded certificate, optionally followed by its intermediate CA certificates. The certificate is uploaded again when the file contains a different certificate than the member serves.",
+	},
+	"private_key": schema.StringAttribute{
+		Optional:  true,
+		WriteOnly: true,
+		Sensitive: true,
+		MarkdownDescription: "The PEM encoded private key of the certificate. Only needed if the key pair was not generated on the member with " +
+			"`nios_security_csr`. This is a write-only attribute.",
+	},
+	"subject": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The subject of the certificate served by the member.",
+	},
+	"issuer": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The issuer of the certificate served by the member.",
+	},
+	"serial": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The serial number in hex format of the certificate served by the member.",
+	},
+	"valid_not_after": schema.Int64Attribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The date after which the certificate served by the member becomes invalid.",
+	},
+	"valid_not_before": schema.Int64Attribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The date before which the certificate served by the member is not valid.",
+	},
+}
+
+// FlattenCertificate sets the computed attributes from the certificate served by the member.
+func (m *HttpsCertificateModel) FlattenCertificate(cert *x509.Certificate) {
+	m.Subject = types.StringValue(cert.Subject.String())
+	m.Issuer = types.StringValue(cert.Issuer.String())
+	m.Serial = types.StringValue(certificateSerial(cert))
+	m.ValidNotAfter = types.Int64Value(cert.NotAfter.Unix())
+	m.ValidNotBefore = types.Int64Value(cert.NotBefore.Unix())
+}
+
+// UploadData returns the certificate followed by the private key, if it is set, as NIOS expects them in one file.
+func (m *HttpsCertificateModel) UploadData(certificate []byte) []byte {
+	if m.PrivateKey.IsNull() || m.PrivateKey.IsUnknown() {
+		return certificate
+	}
+	data := append([]byte{}, certificate...)
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		data = append(data, '\n')
+	}
+	return append(data, m.PrivateKey.ValueString()...)
+}
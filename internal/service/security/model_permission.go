@@ -36,6 +36,22 @@ var PermissionAttrTypes = map[string]attr.Type{
 	"role":          types.StringType,
 }
 
+// permissionResourceTypes are the resource types a permission can apply to.
+var permissionResourceTypes = []string{
+	"A", "AAAA", "AAA_EXTERNAL_SERVICE", "ADD_A_RR_WITH_EMPTY_HOSTNAME", "ALIAS", "BFD_TEMPLATE", "BULKHOST", "CAA", "CA_CERTIFICATE", "CLUSTER",
+	"CNAME", "CSV_IMPORT_TASK", "DASHBOARD_TASK", "DATACOLLECTOR_CLUSTER", "DEFINED_ACL", "DELETED_OBJS_INFO_TRACKING", "DEVICE", "DHCP_FINGERPRINT", "DHCP_LEASE_HISTORY", "DHCP_MAC_FILTER",
+	"DNAME", "DNS64_SYNTHESIS_GROUP", "FILE_DIST_DIRECTORY", "FIREEYE_PUBLISH_ALERT", "FIXED_ADDRESS", "FIXED_ADDRESS_TEMPLATE", "GRID_AAA_PROPERTIES", "GRID_ANALYTICS_PROPERTIES", "GRID_DHCP_PROPERTIES", "GRID_DNS_PROPERTIES",
+	"GRID_FILE_DIST_PROPERTIES", "GRID_REPORTING_PROPERTIES", "GRID_SECURITY_PROPERTIES", "HOST", "HOST_ADDRESS", "HSM_GROUP", "IDNS_CERTIFICATE", "IDNS_GEO_IP", "IDNS_LBDN", "IDNS_LBDN_RECORD",
+	"IDNS_MONITOR", "IDNS_POOL", "IDNS_SERVER", "IDNS_TOPOLOGY", "IMC_AVP", "IMC_PROPERTIES", "IMC_SITE", "IPV6_DHCP_LEASE_HISTORY", "IPV6_FIXED_ADDRESS", "IPV6_FIXED_ADDRESS_TEMPLATE",
+	"IPV6_HOST_ADDRESS", "IPV6_NETWORK", "IPV6_NETWORK_CONTAINER", "IPV6_NETWORK_TEMPLATE", "IPV6_RANGE", "IPV6_RANGE_TEMPLATE", "IPV6_SHARED_NETWORK", "IPV6_TEMPLATE", "KERBEROS_KEY", "MEMBER",
+	"MEMBER_ANALYTICS_PROPERTIES", "MEMBER_CLOUD", "MEMBER_DHCP_PROPERTIES", "MEMBER_DNS_PROPERTIES", "MEMBER_FILE_DIST_PROPERTIES", "MEMBER_SECURITY_PROPERTIES", "MSSERVER", "MS_ADSITES_DOMAIN", "MS_SUPERSCOPE", "MX",
+	"NAPTR", "NETWORK", "NETWORK_CONTAINER", "NETWORK_DISCOVERY", "NETWORK_TEMPLATE", "NETWORK_VIEW", "OCSP_SERVICE", "OPTION_SPACE", "PORT_CONTROL", "PTR",
+	"RANGE", "RANGE_TEMPLATE", "RECLAMATION", "REPORTING_DASHBOARD", "REPORTING_SEARCH", "RESPONSE_POLICY_RULE", "RESPONSE_POLICY_ZONE", "RESTART_SERVICE", "RESTORABLE_OPERATION", "ROAMING_HOST",
+	"RULESET", "SAML_AUTH_SERVICE", "SCHEDULE_TASK", "SG_IPV4_NETWORK", "SG_IPV6_NETWORK", "SG_NETWORK_VIEW", "SHARED_A", "SHARED_AAAA", "SHARED_CNAME", "SHARED_MX",
+	"SHARED_NETWORK", "SHARED_RECORD_GROUP", "SHARED_SRV", "SHARED_TXT", "SRV", "SUB_GRID", "SUB_GRID_NETWORK_VIEW_PARENT", "SUPER_HOST", "TEMPLATE", "TENANT",
+	"TLSA", "TXT", "Unknown", "VIEW", "VLAN_OBJECTS", "VLAN_RANGE", "VLAN_VIEW", "ZONE",
+}
+
 var PermissionResourceSchemaAttributes = map[string]schema.Attribute{
 	"ref": schema.StringAttribute{
 		Computed:            true,
@@ -72,20 +88,7 @@ var PermissionResourceSchemaAttributes = map[string]schema.Attribute{
 				path.MatchRoot("object"),
 				path.MatchRoot("resource_type"),
 			),
-			stringvalidator.OneOf(
-				"A", "AAAA", "AAA_EXTERNAL_SERVICE", "ADD_A_RR_WITH_EMPTY_HOSTNAME", "ALIAS", "BFD_TEMPLATE", "BULKHOST", "CAA", "CA_CERTIFICATE", "CLUSTER",
-				"CNAME", "CSV_IMPORT_TASK", "DASHBOARD_TASK", "DATACOLLECTOR_CLUSTER", "DEFINED_ACL", "DELETED_OBJS_INFO_TRACKING", "DEVICE", "DHCP_FINGERPRINT", "DHCP_LEASE_HISTORY", "DHCP_MAC_FILTER",
-				"DNAME", "DNS64_SYNTHESIS_GROUP", "FILE_DIST_DIRECTORY", "FIREEYE_PUBLISH_ALERT", "FIXED_ADDRESS", "FIXED_ADDRESS_TEMPLATE", "GRID_AAA_PROPERTIES", "GRID_ANALYTICS_PROPERTIES", "GRID_DHCP_PROPERTIES", "GRID_DNS_PROPERTIES",
-				"GRID_FILE_DIST_PROPERTIES", "GRID_REPORTING_PROPERTIES", "GRID_SECURITY_PROPERTIES", "HOST", "HOST_ADDRESS", "HSM_GROUP", "IDNS_CERTIFICATE", "IDNS_GEO_IP", "IDNS_LBDN", "IDNS_LBDN_RECORD",
-				"IDNS_MONITOR", "IDNS_POOL", "IDNS_SERVER", "IDNS_TOPOLOGY", "IMC_AVP", "IMC_PROPERTIES", "IMC_SITE", "IPV6_DHCP_LEASE_HISTORY", "IPV6_FIXED_ADDRESS", "IPV6_FIXED_ADDRESS_TEMPLATE",
-				"IPV6_HOST_ADDRESS", "IPV6_NETWORK", "IPV6_NETWORK_CONTAINER", "IPV6_NETWORK_TEMPLATE", "IPV6_RANGE", "IPV6_RANGE_TEMPLATE", "IPV6_SHARED_NETWORK", "IPV6_TEMPLATE", "KERBEROS_KEY", "MEMBER",
-				"MEMBER_ANALYTICS_PROPERTIES", "MEMBER_CLOUD", "MEMBER_DHCP_PROPERTIES", "MEMBER_DNS_PROPERTIES", "MEMBER_FILE_DIST_PROPERTIES", "MEMBER_SECURITY_PROPERTIES", "MSSERVER", "MS_ADSITES_DOMAIN", "MS_SUPERSCOPE", "MX",
-				"NAPTR", "NETWORK", "NETWORK_CONTAINER", "NETWORK_DISCOVERY", "NETWORK_TEMPLATE", "NETWORK_VIEW", "OCSP_SERVICE", "OPTION_SPACE", "PORT_CONTROL", "PTR",
-				"RANGE", "RANGE_TEMPLATE", "RECLAMATION", "REPORTING_DASHBOARD", "REPORTING_SEARCH", "RESPONSE_POLICY_RULE", "RESPONSE_POLICY_ZONE", "RESTART_SERVICE", "RESTORABLE_OPERATION", "ROAMING_HOST",
-				"RULESET", "SAML_AUTH_SERVICE", "SCHEDULE_TASK", "SG_IPV4_NETWORK", "SG_IPV6_NETWORK", "SG_NETWORK_VIEW", "SHARED_A", "SHARED_AAAA", "SHARED_CNAME", "SHARED_MX",
-				"SHARED_NETWORK", "SHARED_RECORD_GROUP", "SHARED_SRV", "SHARED_TXT", "SRV", "SUB_GRID", "SUB_GRID_NETWORK_VIEW_PARENT", "SUPER_HOST", "TEMPLATE", "TENANT",
-				"TLSA", "TXT", "Unknown", "VIEW", "VLAN_OBJECTS", "VLAN_RANGE", "VLAN_VIEW", "ZONE",
-			),
+			stringvalidator.OneOf(permissionResourceTypes...),
 		},
 		MarkdownDescription: "The type of resource this permission applies to. If 'object' is set, the permission is going to apply to child objects of the specified type, for example if 'object' was set to an authoritative zone reference and 'resource_type' was set to 'A', the permission would apply to A Resource Records within the specified zone.",
 	},
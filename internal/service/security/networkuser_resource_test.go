@@ -0,0 +1,476 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// Network: 10.0.0.0/8 in the default network view
+
+var readableAttributesForNetworkuser = "address,address_object,data_source,data_source_ip,domainname,first_seen_time,guid,last_seen_time,last_updated_time,logon_id,logout_time,name,network,network_view,user_status"
+
+func TestAccNetworkuserResource_basic(t *testing.T) {
+	var resourceName = "nios_security_networkuser.test"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+	address := acctest.RandomIPWithSpecificOctetsSet("10.10.10")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserBasicConfig(name, "corp.example.com", address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "domainname", "corp.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "address", address),
+					resource.TestCheckResourceAttrSet(resourceName, "first_seen_time"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "network_view", "default"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_disappears(t *testing.T) {
+	resourceName := "nios_security_networkuser.test"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+	address := acctest.RandomIPWithSpecificOctetsSet("10.10.10")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkuserDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkuserBasicConfig(name, "corp.example.com", address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					testAccCheckNetworkuserDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkuserResource_Import(t *testing.T) {
+	var resourceName = "nios_security_networkuser.test"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+	address := acctest.RandomIPWithSpecificOctetsSet("10.10.10")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkuserBasicConfig(name, "corp.example.com", address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccNetworkuserImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+		},
+	})
+}
+
+func TestAccNetworkuserResource_Address(t *testing.T) {
+	var resourceName = "nios_security_networkuser.test_address"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+	address := acctest.RandomIPWithSpecificOctetsSet("10.10.10")
+	updatedAddress := acctest.RandomIPWithSpecificOctetsSet("10.10.11")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserAddress(name, "corp.example.com", address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address", address),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserAddress(name, "corp.example.com", updatedAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address", updatedAddress),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_Domainname(t *testing.T) {
+	var resourceName = "nios_security_networkuser.test_domainname"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+	address := acctest.RandomIPWithSpecificOctetsSet("10.10.10")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserDomainname(name, "corp.example.com", address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domainname", "corp.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserDomainname(name, "lab.example.com", address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domainname", "lab.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_FirstSeenTime(t *testing.T) {
+	var resourceName = "nios_security_networkuser.test_first_seen_time"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+	address := acctest.RandomIPWithSpecificOctetsSet("10.10.10")
+	firstSeenTime := time.Now().Add(-2 * time.Hour).Unix()
+	updatedFirstSeenTime := time.Now().Add(-1 * time.Hour).Unix()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserFirstSeenTime(name, "corp.example.com", address, firstSeenTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "first_seen_time", fmt.Sprintf("%d", firstSeenTime)),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserFirstSeenTime(name, "corp.example.com", address, updatedFirstSeenTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "first_seen_time", fmt.Sprintf("%d", updatedFirstSeenTime)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_Guid(t *testing.T) {
+	var resourceName = "nios_security_networkuser.test_guid"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+	address := acctest.RandomIPWithSpecificOctetsSet("10.10.10")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserGuid(name, "corp.example.com", address, "a1b2c3d4-0000-1111-2222-333344445555"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guid", "a1b2c3d4-0000-1111-2222-333344445555"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserGuid(name, "corp.example.com", address, "a1b2c3d4-6666-7777-8888-999900001111"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guid", "a1b2c3d4-6666-7777-8888-999900001111"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_LastSeenTime(t *testing.T) {
+	var resourceName = "nios_security_networkuser.test_last_seen_time"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+	address := acctest.RandomIPWithSpecificOctetsSet("10.10.10")
+	lastSeenTime := time.Now().Add(-1 * time.Hour).Unix()
+	updatedLastSeenTime := time.Now().Unix()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserLastSeenTime(name, "corp.example.com", address, lastSeenTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "last_seen_time", fmt.Sprintf("%d", lastSeenTime)),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserLastSeenTime(name, "corp.example.com", address, updatedLastSeenTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "last_seen_time", fmt.Sprintf("%d", updatedLastSeenTime)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_LogonId(t *testing.T) {
+	var resourceName = "nios_security_networkuser.test_logon_id"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+	address := acctest.RandomIPWithSpecificOctetsSet("10.10.10")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserLogonId(name, "corp.example.com", address, "logon-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "logon_id", "logon-1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserLogonId(name, "corp.example.com", address, "logon-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "logon_id", "logon-2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_Name(t *testing.T) {
+	var resourceName = "nios_security_networkuser.test_name"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+	updatedName := acctest.RandomNameWithPrefix("network-user")
+	address := acctest.RandomIPWithSpecificOctetsSet("10.10.10")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserName(name, "corp.example.com", address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserName(updatedName, "corp.example.com", address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckNetworkuserExists(ctx context.Context, resourceName string, v *security.Networkuser) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			NetworkuserAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForNetworkuser).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetNetworkuserResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetNetworkuserResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckNetworkuserDestroy(ctx context.Context, v *security.Networkuser) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.SecurityAPI.
+			NetworkuserAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForNetworkuser).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckNetworkuserDisappears(ctx context.Context, v *security.Networkuser) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.SecurityAPI.
+			NetworkuserAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccNetworkuserImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccNetworkuserBasicConfig(name, domainname, address string) string {
+	return fmt.Sprintf(`
+resource "nios_security_networkuser" "test" {
+	name = %q
+	domainname = %q
+	address = %q
+}
+`, name, domainname, address)
+}
+
+func testAccNetworkuserAddress(name, domainname, address string) string {
+	return fmt.Sprintf(`
+resource "nios_security_networkuser" "test_address" {
+	name = %q
+	domainname = %q
+	address = %q
+}
+`, name, domainname, address)
+}
+
+func testAccNetworkuserDomainname(name, domainname, address string) string {
+	return fmt.Sprintf(`
+resource "nios_security_networkuser" "test_domainname" {
+	name = %q
+	domainname = %q
+	address = %q
+}
+`, name, domainname, address)
+}
+
+func testAccNetworkuserFirstSeenTime(name, domainname, address string, firstSeenTime int64) string {
+	return fmt.Sprintf(`
+resource "nios_security_networkuser" "test_first_seen_time" {
+	name = %q
+	domainname = %q
+	address = %q
+	first_seen_time = %d
+}
+`, name, domainname, address, firstSeenTime)
+}
+
+func testAccNetworkuserGuid(name, domainname, address string, guid string) string {
+	return fmt.Sprintf(`
+resource "nios_security_networkuser" "test_guid" {
+	name = %q
+	domainname = %q
+	address = %q
+	guid = %q
+}
+`, name, domainname, address, guid)
+}
+
+func testAccNetworkuserLastSeenTime(name, domainname, address string, lastSeenTime int64) string {
+	return fmt.Sprintf(`
+resource "nios_security_networkuser" "test_last_seen_time" {
+	name = %q
+	domainname = %q
+	address = %q
+	last_seen_time = %d
+}
+`, name, domainname, address, lastSeenTime)
+}
+
+func testAccNetworkuserLogonId(name, domainname, address string, logonId string) string {
+	return fmt.Sprintf(`
+resource "nios_security_networkuser" "test_logon_id" {
+	name = %q
+	domainname = %q
+	address = %q
+	logon_id = %q
+}
+`, name, domainname, address, logonId)
+}
+
+func testAccNetworkuserName(name, domainname, address string) string {
+	return fmt.Sprintf(`
+resource "nios_security_networkuser" "test_name" {
+	name = %q
+	domainname = %q
+	address = %q
+}
+`, name, domainname, address)
+}
@@ -0,0 +1,66 @@
+package security_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccUserprofileDataSource_Read(t *testing.T) {
+	dataSourceName := "data.nios_security_userprofile.test"
+	resourceName := "nios_security_userprofile.test"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserprofileDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					}, testAccCheckUserprofileResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckUserprofileResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "active_dashboard_type", dataSourceName, "result.0.active_dashboard_type"),
+		resource.TestCheckResourceAttrPair(resourceName, "admin_group", dataSourceName, "result.0.admin_group"),
+		resource.TestCheckResourceAttrPair(resourceName, "email", dataSourceName, "result.0.email"),
+		resource.TestCheckResourceAttrPair(resourceName, "global_search_on_ea", dataSourceName, "result.0.global_search_on_ea"),
+		resource.TestCheckResourceAttrPair(resourceName, "global_search_on_ni_data", dataSourceName, "result.0.global_search_on_ni_data"),
+		resource.TestCheckResourceAttrPair(resourceName, "grid_admin_groups", dataSourceName, "result.0.grid_admin_groups"),
+		resource.TestCheckResourceAttrPair(resourceName, "lb_tree_nodes_at_gen_level", dataSourceName, "result.0.lb_tree_nodes_at_gen_level"),
+		resource.TestCheckResourceAttrPair(resourceName, "lb_tree_nodes_at_last_level", dataSourceName, "result.0.lb_tree_nodes_at_last_level"),
+		resource.TestCheckResourceAttrPair(resourceName, "max_count_widgets", dataSourceName, "result.0.max_count_widgets"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "table_size", dataSourceName, "result.0.table_size"),
+		resource.TestCheckResourceAttrPair(resourceName, "time_zone", dataSourceName, "result.0.time_zone"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_time_zone", dataSourceName, "result.0.use_time_zone"),
+		resource.TestCheckResourceAttrPair(resourceName, "user_type", dataSourceName, "result.0.user_type"),
+	}
+}
+
+func testAccUserprofileDataSourceConfig() string {
+	return `
+resource "nios_security_userprofile" "test" {
+	table_size = 20
+}
+
+data "nios_security_userprofile" "test" {
+	depends_on = [nios_security_userprofile.test]
+}
+`
+}
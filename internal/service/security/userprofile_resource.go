@@ -0,0 +1,229 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForUserprofile = "active_dashboard_type,admin_group,days_to_expire,email,global_search_on_ea,global_search_on_ni_data,grid_admin_groups,last_login,lb_tree_nodes_at_gen_level,lb_tree_nodes_at_last_level,max_count_widgets,name,table_size,time_zone,use_time_zone,user_type"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserprofileResource{}
+var _ resource.ResourceWithImportState = &UserprofileResource{}
+
+func NewUserprofileResource() resource.Resource {
+	return &UserprofileResource{}
+}
+
+// UserprofileResource defines the resource implementation.
+type UserprofileResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *UserprofileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "security_userprofile"
+}
+
+func (r *UserprofileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the User Profile of the admin the provider is authenticated as. Destroying the resource only removes it from the Terraform state.",
+		Attributes:          UserprofileResourceSchemaAttributes,
+	}
+}
+
+func (r *UserprofileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserprofileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserprofileModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, _, err := r.client.SecurityAPI.
+		UserprofileAPI.
+		List(ctx).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForUserprofile).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Userprofile: %s", err))
+		return
+	}
+
+	list := listResp.ListUserprofileResponseObject.GetResult()
+
+	if len(list) == 0 {
+		resp.Diagnostics.AddError("Not Found", "No Userprofile object exists for the authenticated admin")
+		return
+	}
+
+	// The User Profile of the authenticated admin is the only one returned
+	data.Ref = flex.FlattenStringPointer(list[0].Ref)
+
+	r.update(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserprofileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserprofileModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *security.GetUserprofileResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.SecurityAPI.
+			UserprofileAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForUserprofile).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch()).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Userprofile, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetUserprofileResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserprofileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserprofileModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserprofileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The User Profile belongs to the admin and cannot be deleted, so just clear state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *UserprofileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
+
+// update applies the planned settings to the User Profile referenced by data.Ref.
+func (r *UserprofileResource) update(ctx context.Context, data *UserprofileModel, diags *diag.Diagnostics) {
+	payload := data.Expand(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	var apiRes *security.UpdateUserprofileResponse
+
+	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.SecurityAPI.
+			UserprofileAPI.
+			Update(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+			Userprofile(*payload).
+			ReturnFieldsPlus(readableAttributesForUserprofile).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update Userprofile, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateUserprofileResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, diags)
+}
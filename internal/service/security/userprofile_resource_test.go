@@ -0,0 +1,406 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForUserprofile = "active_dashboard_type,admin_group,days_to_expire,email,global_search_on_ea,global_search_on_ni_data,grid_admin_groups,last_login,lb_tree_nodes_at_gen_level,lb_tree_nodes_at_last_level,max_count_widgets,name,table_size,time_zone,use_time_zone,user_type"
+
+func TestAccUserprofileResource_basic(t *testing.T) {
+	var resourceName = "nios_security_userprofile.test"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccUserprofileBasicConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "admin_group"),
+					resource.TestCheckResourceAttrSet(resourceName, "user_type"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserprofileResource_Import(t *testing.T) {
+	var resourceName = "nios_security_userprofile.test"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserprofileBasicConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccUserprofileImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+				ImportStateVerifyIgnore:              []string{"last_login"},
+			},
+		},
+	})
+}
+
+func TestAccUserprofileResource_Email(t *testing.T) {
+	var resourceName = "nios_security_userprofile.test_email"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccUserprofileEmail("admin@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email", "admin@example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccUserprofileEmail("ops@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email", "ops@example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserprofileResource_GlobalSearchOnEa(t *testing.T) {
+	var resourceName = "nios_security_userprofile.test_global_search_on_ea"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccUserprofileGlobalSearchOnEa(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "global_search_on_ea", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccUserprofileGlobalSearchOnEa(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "global_search_on_ea", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserprofileResource_GlobalSearchOnNiData(t *testing.T) {
+	var resourceName = "nios_security_userprofile.test_global_search_on_ni_data"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccUserprofileGlobalSearchOnNiData(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "global_search_on_ni_data", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccUserprofileGlobalSearchOnNiData(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "global_search_on_ni_data", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserprofileResource_LbTreeNodesAtGenLevel(t *testing.T) {
+	var resourceName = "nios_security_userprofile.test_lb_tree_nodes_at_gen_level"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccUserprofileLbTreeNodesAtGenLevel(5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lb_tree_nodes_at_gen_level", "5"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccUserprofileLbTreeNodesAtGenLevel(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lb_tree_nodes_at_gen_level", "10"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserprofileResource_LbTreeNodesAtLastLevel(t *testing.T) {
+	var resourceName = "nios_security_userprofile.test_lb_tree_nodes_at_last_level"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccUserprofileLbTreeNodesAtLastLevel(5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lb_tree_nodes_at_last_level", "5"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccUserprofileLbTreeNodesAtLastLevel(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lb_tree_nodes_at_last_level", "10"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserprofileResource_MaxCountWidgets(t *testing.T) {
+	var resourceName = "nios_security_userprofile.test_max_count_widgets"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccUserprofileMaxCountWidgets(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "max_count_widgets", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccUserprofileMaxCountWidgets(20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "max_count_widgets", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserprofileResource_TableSize(t *testing.T) {
+	var resourceName = "nios_security_userprofile.test_table_size"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccUserprofileTableSize(20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "table_size", "20"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccUserprofileTableSize(50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "table_size", "50"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserprofileResource_TimeZone(t *testing.T) {
+	var resourceName = "nios_security_userprofile.test_time_zone"
+	var v security.Userprofile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccUserprofileTimeZone("(UTC + 1:00) Amsterdam", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "(UTC + 1:00) Amsterdam"),
+					resource.TestCheckResourceAttr(resourceName, "use_time_zone", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccUserprofileTimeZone("(UTC) Coordinated Universal Time", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserprofileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "(UTC) Coordinated Universal Time"),
+					resource.TestCheckResourceAttr(resourceName, "use_time_zone", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckUserprofileExists(ctx context.Context, resourceName string, v *security.Userprofile) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			UserprofileAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForUserprofile).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetUserprofileResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetUserprofileResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccUserprofileImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccUserprofileBasicConfig() string {
+	return `
+resource "nios_security_userprofile" "test" {
+}
+`
+}
+
+func testAccUserprofileEmail(email string) string {
+	return fmt.Sprintf(`
+resource "nios_security_userprofile" "test_email" {
+	email = %q
+}
+`, email)
+}
+
+func testAccUserprofileGlobalSearchOnEa(globalSearchOnEa bool) string {
+	return fmt.Sprintf(`
+resource "nios_security_userprofile" "test_global_search_on_ea" {
+	global_search_on_ea = %t
+}
+`, globalSearchOnEa)
+}
+
+func testAccUserprofileGlobalSearchOnNiData(globalSearchOnNiData bool) string {
+	return fmt.Sprintf(`
+resource "nios_security_userprofile" "test_global_search_on_ni_data" {
+	global_search_on_ni_data = %t
+}
+`, globalSearchOnNiData)
+}
+
+func testAccUserprofileLbTreeNodesAtGenLevel(lbTreeNodesAtGenLevel int) string {
+	return fmt.Sprintf(`
+resource "nios_security_userprofile" "test_lb_tree_nodes_at_gen_level" {
+	lb_tree_nodes_at_gen_level = %d
+}
+`, lbTreeNodesAtGenLevel)
+}
+
+func testAccUserprofileLbTreeNodesAtLastLevel(lbTreeNodesAtLastLevel int) string {
+	return fmt.Sprintf(`
+resource "nios_security_userprofile" "test_lb_tree_nodes_at_last_level" {
+	lb_tree_nodes_at_last_level = %d
+}
+`, lbTreeNodesAtLastLevel)
+}
+
+func testAccUserprofileMaxCountWidgets(maxCountWidgets int) string {
+	return fmt.Sprintf(`
+resource "nios_security_userprofile" "test_max_count_widgets" {
+	max_count_widgets = %d
+}
+`, maxCountWidgets)
+}
+
+func testAccUserprofileTableSize(tableSize int) string {
+	return fmt.Sprintf(`
+resource "nios_security_userprofile" "test_table_size" {
+	table_size = %d
+}
+`, tableSize)
+}
+
+func testAccUserprofileTimeZone(timeZone string, useTimeZone bool) string {
+	return fmt.Sprintf(`
+resource "nios_security_userprofile" "test_time_zone" {
+	time_zone = %q
+	use_time_zone = %t
+}
+`, timeZone, useTimeZone)
+}
@@ -0,0 +1,309 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/misc"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+)
+
+const scheduledTaskPrefix = "scheduledtask/"
+
+var errApprovalPending = errors.New("scheduled task is waiting for approval or execution")
+
+// ApprovalTransport recognises changes that NIOS queues for approval instead of applying them.
+// For such changes WAPI answers with the reference of the scheduled task holding the change, which the client
+// would otherwise report as a successful create, update or delete.
+//
+// If WaitTimeout is zero the response is replaced by an error naming the task. Otherwise the task is polled until
+// it has been approved and executed, and the response the original request would have returned is rebuilt.
+type ApprovalTransport struct {
+	Transport   http.RoundTripper
+	WaitTimeout time.Duration
+	// InternalIDEA is the extensible attribute used to find objects created by an approved task.
+	InternalIDEA string
+}
+
+// WithApprovalHandling wraps the transport of the HTTP client with an ApprovalTransport.
+func WithApprovalHandling(client *http.Client, waitTimeout time.Duration, internalIDEA string) {
+	if client == nil {
+		return
+	}
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	client.Transport = &ApprovalTransport{
+		Transport:    transport,
+		WaitTimeout:  waitTimeout,
+		InternalIDEA: internalIDEA,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ApprovalTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isApprovalCandidate(req) {
+		return t.Transport.RoundTrip(req)
+	}
+
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	taskRef := scheduledTaskRef(respBody)
+	if taskRef == "" {
+		return resp, nil
+	}
+
+	ctx := req.Context()
+	task, err := t.readScheduledTask(ctx, req, taskRef)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to read scheduled task %s: %s", taskRef, err))
+		task = &misc.Scheduledtask{Ref: &taskRef}
+	}
+
+	if t.WaitTimeout <= 0 {
+		return approvalErrorResponse(req, fmt.Sprintf(
+			"The change was submitted for approval as %s and has not been applied. "+
+				"Approve the task in NIOS and run Terraform again, or set approval_wait_timeout in the provider configuration to wait for the approval. "+
+				"Objects created by the task must be imported once the task has been executed.",
+			describeScheduledTask(task),
+		)), nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Waiting for %s to be approved and executed", describeScheduledTask(task)))
+
+	err = retry.DoWithTimeout(ctx, t.WaitTimeout, func(err error) bool {
+		return errors.Is(err, errApprovalPending) || retry.IsNetworkError(err)
+	}, func(ctx context.Context) (int, error) {
+		current, err := t.readScheduledTask(ctx, req, taskRef)
+		if err != nil {
+			return 0, err
+		}
+		task = current
+		switch {
+		case task.GetApprovalStatus() == "REJECTED":
+			return 0, fmt.Errorf("the change was rejected by %s: %s", task.GetApprover(), task.GetApproverComment())
+		case task.GetExecutionStatus() == "FAILED":
+			return 0, fmt.Errorf("the change was approved but failed to execute: %s", strings.Join(task.GetExecutionDetails(), "; "))
+		case task.GetExecutionStatus() == "COMPLETED":
+			return 0, nil
+		}
+		return 0, errApprovalPending
+	})
+	if err != nil {
+		return approvalErrorResponse(req, fmt.Sprintf("Waiting for %s failed: %s", describeScheduledTask(task), err)), nil
+	}
+
+	return t.completedResponse(ctx, req, reqBody, task)
+}
+
+// completedResponse rebuilds the response of a request whose change was executed by an approved scheduled task.
+func (t *ApprovalTransport) completedResponse(ctx context.Context, req *http.Request, reqBody []byte, task *misc.Scheduledtask) (*http.Response, error) {
+	_, objectPath := splitWAPIPath(req.URL.Path)
+
+	switch req.Method {
+	case http.MethodDelete:
+		body, _ := json.Marshal(objectPath)
+		return jsonResponse(req, http.StatusOK, body), nil
+	case http.MethodPut:
+		status, body, err := t.get(ctx, req, objectPath, req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return jsonResponse(req, status, body), nil
+	}
+
+	var payload struct {
+		ExtAttrs map[string]struct {
+			Value any `json:"value"`
+		} `json:"extattrs"`
+	}
+	_ = json.Unmarshal(reqBody, &payload)
+	internalID, ok := payload.ExtAttrs[t.InternalIDEA]
+	if t.InternalIDEA == "" || !ok {
+		return approvalErrorResponse(req, fmt.Sprintf(
+			"The change was applied by %s, but the created object cannot be looked up. Import the object to manage it with Terraform.",
+			describeScheduledTask(task),
+		)), nil
+	}
+
+	query := req.URL.Query()
+	query.Set("*"+t.InternalIDEA, fmt.Sprint(internalID.Value))
+	status, body, err := t.get(ctx, req, objectPath, query)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return jsonResponse(req, status, body), nil
+	}
+
+	var list struct {
+		Result []json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil || len(list.Result) != 1 {
+		return approvalErrorResponse(req, fmt.Sprintf(
+			"The change was applied by %s, but the created object cannot be looked up. Import the object to manage it with Terraform.",
+			describeScheduledTask(task),
+		)), nil
+	}
+	body, _ = json.Marshal(map[string]json.RawMessage{"result": list.Result[0]})
+	return jsonResponse(req, http.StatusCreated, body), nil
+}
+
+func (t *ApprovalTransport) readScheduledTask(ctx context.Context, req *http.Request, taskRef string) (*misc.Scheduledtask, error) {
+	query := url.Values{}
+	query.Set("_return_fields+", "task_id,approval_status,approver,approver_comment,execution_status,execution_details")
+	query.Set("_return_as_object", "1")
+
+	status, body, err := t.get(ctx, req, taskRef, query)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("reading %s failed with status %d: %s", taskRef, status, string(body))
+	}
+
+	var res struct {
+		Result misc.Scheduledtask `json:"result"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", taskRef, err)
+	}
+	return &res.Result, nil
+}
+
+// get sends a GET request for the object path with the credentials of the original request.
+func (t *ApprovalTransport) get(ctx context.Context, orig *http.Request, objectPath string, query url.Values) (int, []byte, error) {
+	basePath, _ := splitWAPIPath(orig.URL.Path)
+	u := *orig.URL
+	u.Path = basePath + "/" + objectPath
+	u.RawPath = ""
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header = orig.Header.Clone()
+	req.Header.Del("Content-Type")
+	req.Header.Del("Content-Length")
+	// The transports of the client read the request body, which must not be nil
+	req.Body = http.NoBody
+
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, body, nil
+}
+
+// isApprovalCandidate reports whether the request changes an object and may therefore be queued for approval.
+func isApprovalCandidate(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	if req.URL.Query().Has("_function") {
+		return false
+	}
+	_, objectPath := splitWAPIPath(req.URL.Path)
+	return objectPath != "" && !strings.HasPrefix(objectPath, scheduledTaskPrefix) && objectPath != "request"
+}
+
+// scheduledTaskRef returns the scheduled task reference WAPI returns for a queued change, either as a plain
+// reference or wrapped in a result object.
+func scheduledTaskRef(body []byte) string {
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return ""
+	}
+	if obj, ok := value.(map[string]any); ok {
+		value = obj["result"]
+		if result, ok := value.(map[string]any); ok {
+			value = result["_ref"]
+		}
+	}
+	if ref, ok := value.(string); ok && strings.HasPrefix(ref, scheduledTaskPrefix) {
+		return ref
+	}
+	return ""
+}
+
+// splitWAPIPath splits a request path such as /wapi/v2.13.6/record:a/ZG5z:name/default into the WAPI base path
+// and the object path.
+func splitWAPIPath(p string) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(p, "/"), "/", 3)
+	if len(parts) < 3 {
+		return p, ""
+	}
+	return "/" + parts[0] + "/" + parts[1], parts[2]
+}
+
+func describeScheduledTask(task *misc.Scheduledtask) string {
+	if task.HasTaskId() {
+		return fmt.Sprintf("scheduled task %d (%s)", task.GetTaskId(), task.GetRef())
+	}
+	return fmt.Sprintf("scheduled task %s", task.GetRef())
+}
+
+// approvalErrorResponse returns a WAPI style error response, so the client reports the message as an API error.
+func approvalErrorResponse(req *http.Request, message string) *http.Response {
+	body, _ := json.Marshal(map[string]string{
+		"Error": message,
+		"text":  message,
+	})
+	return jsonResponse(req, http.StatusConflict, body)
+}
+
+func jsonResponse(req *http.Request, status int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
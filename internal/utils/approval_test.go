@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/option"
+)
+
+const testTaskRef = "scheduledtask/b25lLnF1ZXVlZF90YXNrJDEy:12/PENDING"
+
+// newApprovalTestServer returns a WAPI stub that queues every change as scheduled task 12 with the given statuses.
+func newApprovalTestServer(approvalStatus, executionStatus string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/wapi/v2.13.6/scheduledtask/"):
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"result": map[string]any{
+					"_ref":             testTaskRef,
+					"task_id":          12,
+					"approval_status":  approvalStatus,
+					"approver":         "approver",
+					"approver_comment": "not now",
+					"execution_status": executionStatus,
+				},
+			})
+		case r.Method == http.MethodGet && r.URL.Query().Has("*Terraform Internal ID"):
+			_, _ = io.WriteString(w, `{"result": [{"_ref": "record:a/ZG5z:a.example.com/default", "name": "a.example.com"}]}`)
+		case r.Method == http.MethodGet:
+			_, _ = io.WriteString(w, `{"result": {"_ref": "record:a/ZG5z:a.example.com/default", "name": "b.example.com"}}`)
+		case strings.Contains(r.URL.Path, "unqueued"):
+			_, _ = io.WriteString(w, `{"result": {"_ref": "record:a/ZG5z:unqueued/default"}}`)
+		default:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `"`+testTaskRef+`"`)
+		}
+	}))
+}
+
+func doApprovalRequest(t *testing.T, server *httptest.Server, waitTimeout time.Duration, method, path, body string) (*http.Response, string) {
+	// Wrap the transport of a NIOS client as the provider does
+	client := niosclient.NewAPIClient(
+		option.WithNIOSHostUrl(server.URL),
+		option.WithNIOSUsername("admin"),
+		option.WithNIOSPassword("infoblox"),
+		option.WithDebug(false),
+	).DNSAPI.Cfg.HTTPClient
+	WithApprovalHandling(client, waitTimeout, "Terraform Internal ID")
+
+	req, err := http.NewRequest(method, server.URL+"/wapi/v2.13.6/"+path+"?_return_as_object=1", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Unexpected error creating request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Unexpected error reading response: %v", err)
+	}
+	return resp, string(respBody)
+}
+
+// TestApprovalTransport_NotQueued tests that responses of changes applied directly are passed through
+func TestApprovalTransport_NotQueued(t *testing.T) {
+	server := newApprovalTestServer("PENDING", "PENDING")
+	defer server.Close()
+
+	resp, body := doApprovalRequest(t, server, 0, http.MethodPut, "record:a/ZG5z:unqueued", `{}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got: %d", resp.StatusCode)
+	}
+	if !strings.Contains(body, "unqueued") {
+		t.Errorf("Expected original response body, got: %s", body)
+	}
+}
+
+// TestApprovalTransport_PendingWithoutWait tests that a queued change fails with the task ID when not waiting
+func TestApprovalTransport_PendingWithoutWait(t *testing.T) {
+	server := newApprovalTestServer("PENDING", "PENDING")
+	defer server.Close()
+
+	resp, body := doApprovalRequest(t, server, 0, http.MethodPost, "record:a", `{"name": "a.example.com"}`)
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("Expected status 409, got: %d", resp.StatusCode)
+	}
+	if !strings.Contains(body, "scheduled task 12") {
+		t.Errorf("Expected error to name the scheduled task, got: %s", body)
+	}
+}
+
+// TestApprovalTransport_Rejected tests that waiting stops when the approver rejects the change
+func TestApprovalTransport_Rejected(t *testing.T) {
+	server := newApprovalTestServer("REJECTED", "PENDING")
+	defer server.Close()
+
+	resp, body := doApprovalRequest(t, server, 10*time.Second, http.MethodDelete, "record:a/ZG5z:a.example.com/default", "")
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("Expected status 409, got: %d", resp.StatusCode)
+	}
+	if !strings.Contains(body, "rejected by approver: not now") {
+		t.Errorf("Expected rejection to be reported, got: %s", body)
+	}
+}
+
+// TestApprovalTransport_CompletedUpdate tests that the updated object is returned once the task is executed
+func TestApprovalTransport_CompletedUpdate(t *testing.T) {
+	server := newApprovalTestServer("APPROVED", "COMPLETED")
+	defer server.Close()
+
+	resp, body := doApprovalRequest(t, server, 10*time.Second, http.MethodPut, "record:a/ZG5z:a.example.com/default", `{"name": "b.example.com"}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got: %d", resp.StatusCode)
+	}
+	if !strings.Contains(body, "b.example.com") {
+		t.Errorf("Expected updated object, got: %s", body)
+	}
+}
+
+// TestApprovalTransport_CompletedCreate tests that the created object is looked up by its internal ID
+func TestApprovalTransport_CompletedCreate(t *testing.T) {
+	server := newApprovalTestServer("APPROVED", "COMPLETED")
+	defer server.Close()
+
+	resp, body := doApprovalRequest(t, server, 10*time.Second, http.MethodPost, "record:a",
+		`{"name": "a.example.com", "extattrs": {"Terraform Internal ID": {"value": "1234"}}}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Expected status 201, got: %d", resp.StatusCode)
+	}
+
+	var res struct {
+		Result struct {
+			Name string `json:"name"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal([]byte(body), &res); err != nil || res.Result.Name != "a.example.com" {
+		t.Errorf("Expected created object as result, got: %s", body)
+	}
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DownloadInitResponse represents the response of NIOS functions that prepare a file for download
+type DownloadInitResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// DownloadFileWithFunction calls a WAPI function that prepares a file for download, such as fileop generatecsr or
+// downloadcertificate, downloads the file and releases it on NIOS with the fileop downloadcomplete function.
+// It returns the content of the downloaded file.
+func DownloadFileWithFunction(ctx context.Context, baseURL, username, password, ref, function string, args map[string]any) ([]byte, error) {
+	respBody, err := CallWAPIFunction(ctx, baseURL, username, password, ref, function, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var downloadInitResponse DownloadInitResponse
+	if err := json.Unmarshal(respBody, &downloadInitResponse); err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", function, err)
+	}
+	if downloadInitResponse.URL == "" {
+		return nil, fmt.Errorf("%s response does not contain a download URL: %s", function, string(respBody))
+	}
+
+	content, err := DownloadFile(ctx, downloadInitResponse.URL, username, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := CallWAPIFunction(ctx, baseURL, username, password, "fileop", "downloadcomplete", map[string]any{
+		"token": downloadInitResponse.Token,
+	}); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to release downloaded file %s: %s", downloadInitResponse.URL, err))
+	}
+
+	return content, nil
+}
+
+// DownloadFile downloads a file from the Infoblox NIOS server using the provided download URL.
+func DownloadFile(ctx context.Context, downloadURL, username, password string) ([]byte, error) {
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", downloadURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating file download request: %w", err)
+	}
+
+	req.SetBasicAuth(username, password)
+
+	tflog.Debug(ctx, fmt.Sprintf("Downloading the file from: %s", downloadURL))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading the file: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the downloaded file: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("file download failed with status %d: %s", resp.StatusCode, string(content))
+	}
+
+	return content, nil
+}
@@ -73,3 +73,15 @@ func GetNIOSSyslogEndpointRef() string {
 func GetSubscriberBlockSizeEditable() string {
 	return os.Getenv("SUBSCRIBER_BLOCK_SIZE_EDITABLE")
 }
+
+func GetNIOSMemberLicenseKey() string {
+	return os.Getenv("NIOS_MEMBER_LICENSE_KEY")
+}
+
+func GetNIOSGridwideLicenseKey() string {
+	return os.Getenv("NIOS_GRIDWIDE_LICENSE_KEY")
+}
+
+func GetNIOSJoinMemberURL() string {
+	return os.Getenv("NIOS_JOIN_MEMBER_URL")
+}
@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CallWAPIFunction calls a WAPI function on the object identified by ref, for functions the client does not model.
+// The response body is returned as is.
+func CallWAPIFunction(ctx context.Context, baseURL, username, password, ref, function string, args map[string]any) ([]byte, error) {
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	if args == nil {
+		args = map[string]any{}
+	}
+	body, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %s arguments: %w", function, err)
+	}
+
+	functionURL := fmt.Sprintf("%s/wapi/v2.13.6/%s?_function=%s", baseURL, ref, function)
+	req, err := http.NewRequestWithContext(ctx, "POST", functionURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", function, err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(username, password)
+
+	tflog.Debug(ctx, fmt.Sprintf("Making %s request to: %s", function, functionURL))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making %s request: %w", function, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s response: %w", function, err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s request failed with status %d: %s", function, resp.StatusCode, string(respBody))
+	}
+
+	return respBody, nil
+}
+
+// ListWAPIObjectRefs searches objects of the given WAPI object type that match all filters and returns their references.
+// It is used to look up objects by name for object types the provider has no typed client for.
+func ListWAPIObjectRefs(ctx context.Context, baseURL, username, password, objectType string, filters map[string]string) ([]string, error) {
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	query := url.Values{}
+	for k, v := range filters {
+		query.Set(k, v)
+	}
+
+	searchURL := fmt.Sprintf("%s/wapi/v2.13.6/%s?%s", baseURL, objectType, query.Encode())
+	req, err := http.NewRequestWithContext(ctx, "GET", searchURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s search request: %w", objectType, err)
+	}
+
+	req.SetBasicAuth(username, password)
+
+	tflog.Debug(ctx, fmt.Sprintf("Making %s search request to: %s", objectType, searchURL))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making %s search request: %w", objectType, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s search response: %w", objectType, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s search request failed with status %d: %s", objectType, resp.StatusCode, string(respBody))
+	}
+
+	var objects []struct {
+		Ref string `json:"_ref"`
+	}
+	if err := json.Unmarshal(respBody, &objects); err != nil {
+		return nil, fmt.Errorf("error decoding %s search response: %w", objectType, err)
+	}
+
+	refs := make([]string, 0, len(objects))
+	for _, o := range objects {
+		refs = append(refs, o.Ref)
+	}
+	return refs, nil
+}
+
+// WAPIRequest is a single operation of a WAPI multiple request. Object is the object type for POST requests
+// and the reference of the object for PUT and DELETE requests.
+type WAPIRequest struct {
+	Method string         `json:"method"`
+	Object string         `json:"object"`
+	Data   map[string]any `json:"data,omitempty"`
+}
+
+// CallWAPIRequest sends the operations as one WAPI multiple request. NIOS runs them in a single transaction,
+// so either all of them are applied or none.
+func CallWAPIRequest(ctx context.Context, baseURL, username, password string, requests []WAPIRequest) error {
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	body, err := json.Marshal(requests)
+	if err != nil {
+		return fmt.Errorf("error encoding multiple request: %w", err)
+	}
+
+	requestURL := fmt.Sprintf("%s/wapi/v2.13.6/request", baseURL)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating multiple request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(username, password)
+
+	tflog.Debug(ctx, fmt.Sprintf("Making multiple request with %d operations to: %s", len(requests), requestURL))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making multiple request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading multiple request response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("multiple request failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
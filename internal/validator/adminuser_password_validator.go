@@ -1,17 +1,11 @@
 package validator
 
 import (
-	"context"
+	"fmt"
 	"regexp"
-
-	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"unicode/utf8"
 )
 
-var _ validator.String = passwordStrengthValidator{}
-
-type passwordStrengthValidator struct{}
-
 var (
 	lowercaseRegex = regexp.MustCompile(`[a-z]`)
 	uppercaseRegex = regexp.MustCompile(`[A-Z]`)
@@ -19,35 +13,44 @@ var (
 	symbolRegex    = regexp.MustCompile(`[^a-zA-Z0-9]`)
 )
 
-func (v passwordStrengthValidator) Description(ctx context.Context) string {
-	return "Password must be at least 4 characters long and include at least one lowercase letter, one uppercase letter, one digit, and one symbol."
+// PasswordPolicy holds the password complexity requirements configured on the Grid.
+type PasswordPolicy struct {
+	MinLength  int64
+	NumLower   int64
+	NumUpper   int64
+	NumNumeric int64
+	NumSymbol  int64
 }
 
-func (v passwordStrengthValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+// DefaultPasswordPolicy is the policy used when the password policy of the Grid cannot be read.
+var DefaultPasswordPolicy = PasswordPolicy{
+	MinLength:  4,
+	NumLower:   1,
+	NumUpper:   1,
+	NumNumeric: 1,
+	NumSymbol:  1,
 }
 
-func (v passwordStrengthValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
-	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
-		return
-	}
-
-	value := req.ConfigValue.ValueString()
+// CheckPasswordPolicy returns a description of every requirement of the policy that the password does not meet.
+func CheckPasswordPolicy(password string, policy PasswordPolicy) []string {
+	var violations []string
 
-	if len(value) < 4 ||
-		!lowercaseRegex.MatchString(value) ||
-		!uppercaseRegex.MatchString(value) ||
-		!digitRegex.MatchString(value) ||
-		!symbolRegex.MatchString(value) {
-		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-			req.Path,
-			"Password does not meet requirements. Password must contain at least 4 characters, including at least 1 lowercase character, 1 uppercase character, 1 numeric character, 1 symbol character.",
-			value,
-		))
+	if int64(utf8.RuneCountInString(password)) < policy.MinLength {
+		violations = append(violations, fmt.Sprintf("at least %d characters", policy.MinLength))
 	}
-}
-
-// IsStrongPassword returns a validator.String that enforces password strength rules.
-func IsStrongPassword() validator.String {
-	return passwordStrengthValidator{}
+	for _, req := range []struct {
+		regex *regexp.Regexp
+		min   int64
+		kind  string
+	}{
+		{lowercaseRegex, policy.NumLower, "lowercase"},
+		{uppercaseRegex, policy.NumUpper, "uppercase"},
+		{digitRegex, policy.NumNumeric, "numeric"},
+		{symbolRegex, policy.NumSymbol, "symbol"},
+	} {
+		if int64(len(req.regex.FindAllString(password, -1))) < req.min {
+			violations = append(violations, fmt.Sprintf("at least %d %s characters", req.min, req.kind))
+		}
+	}
+	return violations
 }
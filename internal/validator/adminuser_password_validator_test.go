@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckPasswordPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		policy   PasswordPolicy
+		want     []string
+	}{
+		{"empty policy", "a", PasswordPolicy{}, nil},
+		{"meets default policy", "Ab1!", DefaultPasswordPolicy, nil},
+		{
+			"fails every default requirement", "",
+			DefaultPasswordPolicy,
+			[]string{"at least 4 characters", "at least 1 lowercase characters", "at least 1 uppercase characters", "at least 1 numeric characters", "at least 1 symbol characters"},
+		},
+		{"too short", "Ab1!", PasswordPolicy{MinLength: 8}, []string{"at least 8 characters"}},
+		{"length counts characters", "wörd", PasswordPolicy{MinLength: 5}, []string{"at least 5 characters"}},
+		{"counts each character class", "aB12!?", PasswordPolicy{NumLower: 2, NumUpper: 1, NumNumeric: 2, NumSymbol: 2}, []string{"at least 2 lowercase characters"}},
+		{"spaces and unicode are symbols", "pass wörd", PasswordPolicy{NumSymbol: 2}, nil},
+		{"digits are not symbols", "abc123", PasswordPolicy{NumSymbol: 1}, []string{"at least 1 symbol characters"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckPasswordPolicy(tt.password, tt.policy); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckPasswordPolicy(%q) = %q, expected %q", tt.password, got, tt.want)
+			}
+		})
+	}
+}
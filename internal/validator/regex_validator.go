@@ -0,0 +1,39 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// regexValidator validates if the provided value is a valid POSIX regular expression.
+type regexValidator struct{}
+
+func IsValidRegex() validator.String {
+	return regexValidator{}
+}
+
+func (v regexValidator) Description(ctx context.Context) string {
+	return "Validator to check if a value is a valid POSIX regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := regexp.CompilePOSIX(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Value %q is not a valid POSIX regular expression: %s", value, err),
+		)
+	}
+}